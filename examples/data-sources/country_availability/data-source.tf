@@ -0,0 +1,10 @@
+# Fail the plan if production is distributed outside of the expected countries
+data "googleplay_country_availability" "production" {
+  package_name       = "com.example.app"
+  track              = "production"
+  expected_countries = ["GB", "IE", "US"]
+}
+
+output "rest_of_world" {
+  value = data.googleplay_country_availability.production.rest_of_world
+}
@@ -0,0 +1,5 @@
+data "googleplay_crash_rate" "release" {
+  package_name = "com.example.app"
+  version_code = 1042
+  days         = 1
+}
@@ -0,0 +1,18 @@
+data "googleplay_reviews" "low_ratings" {
+  package_name         = "com.example.app"
+  translation_language = "en"
+  star_ratings         = [1, 2]
+  version_codes        = [210]
+}
+
+# Reply to every low rating of version 210 which has not been answered yet
+resource "googleplay_review_reply" "crash_fix" {
+  for_each = {
+    for review in data.googleplay_reviews.low_ratings.reviews :
+    review.review_id => review if review.developer_reply == null
+  }
+
+  package_name = "com.example.app"
+  review_id    = each.key
+  reply_text   = "Sorry about the crash on launch, ${each.value.author_name}. It is fixed in version 2.2."
+}
@@ -0,0 +1,16 @@
+data "googleplay_subscriptions" "catalog" {
+  package_name = "com.example.app"
+  active_only  = true
+}
+
+# The price of every active base plan in the US, for server configuration
+output "us_prices" {
+  value = {
+    for product_id, subscription in data.googleplay_subscriptions.catalog.subscriptions :
+    product_id => {
+      for base_plan_id, base_plan in subscription.base_plans :
+      base_plan_id => base_plan.regional_prices["US"].amount
+      if contains(keys(base_plan.regional_prices), "US")
+    }
+  }
+}
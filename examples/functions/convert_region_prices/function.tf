@@ -0,0 +1,24 @@
+locals {
+  # Local prices in every region, converted from 9.99 USD
+  premium_prices = provider::googleplay::convert_region_prices("com.example.app", 9.99, "USD")
+}
+
+resource "googleplay_subscription_base_plan" "monthly" {
+  package_name = "com.example.app"
+  product_id   = "premium"
+  base_plan_id = "monthly"
+
+  auto_renewing = {
+    billing_period = "P1M"
+  }
+
+  regional_configs = {
+    for region, price in local.premium_prices : region => {
+      price = {
+        units    = price.units
+        nanos    = price.nanos
+        currency = price.currency
+      }
+    }
+  }
+}
@@ -0,0 +1,10 @@
+locals {
+  prices = provider::googleplay::convert_region_prices("com.example.app", 9.99, "USD")
+}
+
+output "local_prices" {
+  # { GB = "7.99 GBP", JP = "1500 JPY", ... }
+  value = {
+    for region, price in local.prices : region => provider::googleplay::format_money(price)
+  }
+}
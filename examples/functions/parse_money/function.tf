@@ -0,0 +1,22 @@
+locals {
+  # { currency = "USD", units = 9, nanos = 990000000, price_micros = 9990000 }
+  price = provider::googleplay::parse_money("9.99 USD")
+}
+
+resource "googleplay_inapp_product" "coins" {
+  package_name     = "com.example.app"
+  sku              = "coins_100"
+  default_language = "en-GB"
+
+  default_price = {
+    price_micros = local.price.price_micros
+    currency     = local.price.currency
+  }
+
+  listings = {
+    en-GB = {
+      title       = "100 coins"
+      description = "A pile of coins"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+locals {
+  # { currency = "EUR", units = 8, nanos = 990000000, price_micros = 8990000 }
+  price = provider::googleplay::round_price_ending(provider::googleplay::parse_money("8.49 EUR"))
+}
+
+resource "googleplay_subscription_base_plan" "monthly" {
+  package_name = "com.example.app"
+  product_id   = "premium"
+  base_plan_id = "monthly"
+
+  auto_renewing = {
+    billing_period = "P1M"
+  }
+
+  regional_configs = {
+    DE = { price = { amount = provider::googleplay::format_money(local.price) } }
+  }
+}
@@ -0,0 +1,15 @@
+resource "googleplay_apk" "app" {
+  package_name = "com.example.app"
+  file         = "build/outputs/apk/release/app-release.apk"
+
+  expansion_file {
+    type = "main"
+    file = "build/outputs/obb/main.obb"
+  }
+
+  # Reuse the patch expansion file uploaded with an earlier version
+  expansion_file {
+    type               = "patch"
+    references_version = 41
+  }
+}
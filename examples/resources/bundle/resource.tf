@@ -0,0 +1,11 @@
+resource "googleplay_bundle" "app" {
+  package_name = "com.example.app"
+  file         = "${path.module}/app/build/outputs/bundle/release/app-release.aab"
+}
+
+resource "googleplay_track_release" "internal" {
+  package_name  = "com.example.app"
+  track         = "internal"
+  version_codes = [googleplay_bundle.app.version_code]
+  status        = "completed"
+}
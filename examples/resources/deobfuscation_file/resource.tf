@@ -0,0 +1,18 @@
+resource "googleplay_bundle" "app" {
+  package_name = "com.example.app"
+  file         = "app/build/outputs/bundle/release/app-release.aab"
+}
+
+resource "googleplay_deobfuscation_file" "mapping" {
+  package_name = "com.example.app"
+  version_code = googleplay_bundle.app.version_code
+  type         = "proguard"
+  file         = "app/build/outputs/mapping/release/mapping.txt"
+}
+
+resource "googleplay_deobfuscation_file" "symbols" {
+  package_name = "com.example.app"
+  version_code = googleplay_bundle.app.version_code
+  type         = "nativeCode"
+  file         = "app/build/outputs/native-debug-symbols/release/native-debug-symbols.zip"
+}
@@ -0,0 +1,26 @@
+resource "googleplay_inapp_product" "coins" {
+  package_name     = "com.example.app"
+  sku              = "coins_100"
+  default_language = "en-GB"
+
+  default_price = {
+    price_micros = 990000
+    currency     = "GBP"
+  }
+
+  # Convert the default price for every region not listed in prices
+  auto_convert_missing_prices = true
+  prices = {
+    US = {
+      price_micros = 990000
+      currency     = "USD"
+    }
+  }
+
+  listings = {
+    "en-GB" = {
+      title       = "100 coins"
+      description = "A pile of 100 coins to spend in the shop."
+    }
+  }
+}
@@ -0,0 +1,28 @@
+locals {
+  coin_packs = {
+    coins_100  = { coins = 100, price_micros = 990000 }
+    coins_500  = { coins = 500, price_micros = 3990000 }
+    coins_1000 = { coins = 1000, price_micros = 6990000 }
+  }
+}
+
+resource "googleplay_inapp_products" "coins" {
+  package_name                = "com.example.app"
+  auto_convert_missing_prices = true
+
+  products = {
+    for sku, pack in local.coin_packs : sku => {
+      default_language = "en-GB"
+      default_price = {
+        price_micros = pack.price_micros
+        currency     = "GBP"
+      }
+      listings = {
+        "en-GB" = {
+          title       = "${pack.coins} coins"
+          description = "A pile of ${pack.coins} coins to spend in the shop."
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,8 @@
+resource "googleplay_internal_app_sharing_artifact" "nightly" {
+  package_name = "com.example.app"
+  file         = "build/outputs/bundle/release/app-release.aab"
+}
+
+output "nightly_download_url" {
+  value = googleplay_internal_app_sharing_artifact.nightly.download_url
+}
@@ -0,0 +1,9 @@
+resource "googleplay_listing_images" "phone_screenshots" {
+  package_name = "com.example.app"
+  language     = "en-GB"
+  image_type   = "phoneScreenshots"
+  files = [
+    "${path.module}/screenshots/en-GB/home.png",
+    "${path.module}/screenshots/en-GB/settings.png",
+  ]
+}
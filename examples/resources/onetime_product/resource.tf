@@ -0,0 +1,51 @@
+resource "googleplay_onetime_product" "movie" {
+  package_name = "com.example.app"
+  product_id   = "movie"
+
+  listings = {
+    "en-GB" = {
+      title       = "The Movie"
+      description = "Watch the movie as often as you like."
+    }
+  }
+
+  purchase_options = {
+    # Buy to keep, also offered to apps using older billing libraries
+    buy = {
+      buy = { legacy_compatible = true }
+      regional_configs = {
+        GB = { price = { amount = "9.99 GBP" } }
+        US = { price = { amount = "12.99 USD" } }
+      }
+      other_regions = {
+        usd_price = { amount = "12.99 USD" }
+        eur_price = { amount = "11.99 EUR" }
+      }
+
+      offers = {
+        launch = {
+          start_time = "2025-11-28T00:00:00Z"
+          end_time   = "2025-12-01T23:59:59Z"
+          regional_configs = {
+            GB = { relative_discount = 0.25 }
+            US = { absolute_discount = { amount = "3.00 USD" } }
+          }
+          offer_tags = ["black-friday"]
+        }
+      }
+    }
+
+    # Rent for a week, with 48 hours to finish watching once started
+    rent = {
+      rent = {
+        rental_period     = "P7D"
+        expiration_period = "PT48H"
+      }
+      regional_configs = {
+        GB = { price = { amount = "3.49 GBP" } }
+        US = { price = { amount = "3.99 USD" } }
+      }
+    }
+  }
+}
+
@@ -0,0 +1,5 @@
+resource "googleplay_review_reply" "thanks" {
+  package_name = "com.example.app"
+  review_id    = "gp:AOqpTOE..."
+  reply_text   = "Thanks for the review! The crash on launch is fixed in version 2.1."
+}
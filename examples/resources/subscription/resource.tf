@@ -0,0 +1,18 @@
+resource "googleplay_subscription" "premium" {
+  package_name = "com.example.app"
+  product_id   = "premium"
+
+  listings = {
+    "en-GB" = {
+      title    = "Premium"
+      benefits = ["No adverts", "Offline mode"]
+    }
+  }
+
+  tax_and_compliance = {
+    eea_withdrawal_right_type = "WITHDRAWAL_RIGHT_SERVICE"
+  }
+
+  # Only accept payment methods registered in these regions
+  restricted_payment_countries = ["IN"]
+}
@@ -0,0 +1,22 @@
+resource "googleplay_subscription_base_plan" "monthly" {
+  package_name = googleplay_subscription.premium.package_name
+  product_id   = googleplay_subscription.premium.product_id
+  base_plan_id = "monthly"
+
+  auto_renewing = {
+    billing_period    = "P1M"
+    grace_period      = "P7D"
+    resubscribe_state = "RESUBSCRIBE_STATE_ACTIVE"
+  }
+
+  regional_configs = {
+    GB = { price = { units = 4, nanos = 990000000, currency = "GBP" } }
+    US = { price = { amount = "5.99 USD" } }
+  }
+
+  # Prices for regions Google Play adds in future
+  other_regions = {
+    usd_price = { units = 5, nanos = 990000000, currency = "USD" }
+    eur_price = { units = 5, nanos = 490000000, currency = "EUR" }
+  }
+}
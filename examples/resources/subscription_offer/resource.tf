@@ -0,0 +1,36 @@
+resource "googleplay_subscription_offer" "trial" {
+  package_name = "com.example.app"
+  product_id   = "premium"
+  base_plan_id = "monthly"
+  offer_id     = "free-trial"
+
+  regional_configs = {
+    GB = {}
+    US = {}
+  }
+
+  phases = [
+    # A one week free trial...
+    {
+      duration = "P1W"
+      regional_configs = {
+        GB = { free = true }
+        US = { free = true }
+      }
+    },
+    # ...followed by three months at half price
+    {
+      duration         = "P1M"
+      recurrence_count = 3
+      regional_configs = {
+        GB = { relative_discount = 0.5 }
+        US = { relative_discount = 0.5 }
+      }
+    },
+  ]
+
+  targeting = {
+    acquisition = { scope = "this_subscription" }
+  }
+  offer_tags = ["intro"]
+}
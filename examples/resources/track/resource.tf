@@ -0,0 +1,15 @@
+resource "googleplay_track" "qa" {
+  package_name = "com.example.app"
+  track        = "qa"
+}
+
+resource "googleplay_track" "wear_qa" {
+  package_name = "com.example.app"
+  track        = "wear:qa"
+}
+
+resource "googleplay_track_testers" "qa" {
+  package_name  = googleplay_track.qa.package_name
+  track         = googleplay_track.qa.track
+  google_groups = ["qa-team@example.com"]
+}
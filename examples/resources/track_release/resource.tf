@@ -0,0 +1,33 @@
+resource "googleplay_track_release" "production" {
+  package_name  = "com.example.app"
+  track         = "production"
+  version_codes = [1042]
+  status        = "inProgress"
+  user_fraction = 0.05
+  name          = "4.2.0"
+
+  # Production is protected by default, so changes must be explicitly allowed
+  allow_production_changes = true
+
+  release_notes = {
+    "en-GB" = "Bug fixes and performance improvements."
+  }
+}
+
+data "googleplay_crash_rate" "beta" {
+  package_name = "com.example.app"
+  version_code = 1043
+}
+
+resource "googleplay_track_release" "beta" {
+  package_name  = "com.example.app"
+  track         = "beta"
+  version_codes = [1043]
+
+  rollout_schedule {
+    steps              = [0.01, 0.05, 0.2, 1]
+    min_soak_time      = "24h"
+    halt_on_crash_rate = 0.02
+    crash_rate         = data.googleplay_crash_rate.beta.crash_rate
+  }
+}
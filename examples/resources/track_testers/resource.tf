@@ -0,0 +1,8 @@
+resource "googleplay_track_testers" "alpha" {
+  package_name = "com.example.app"
+  track        = "alpha"
+  google_groups = [
+    "qa-team@example.com",
+    "beta-testers@googlegroups.com",
+  ]
+}
@@ -0,0 +1,533 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &ApkResource{}
+var _ resource.ResourceWithValidateConfig = &ApkResource{}
+var _ resource.ResourceWithModifyPlan = &ApkResource{}
+var _ resource.ResourceWithImportState = &ApkResource{}
+
+// expansionFileTypes are the OBB expansion files an APK can have.
+var expansionFileTypes = []string{"main", "patch"}
+
+func NewApkResource() resource.Resource {
+	return &ApkResource{}
+}
+
+type ApkResource struct {
+	client *GooglePlayClient
+}
+
+type apkResourceModel struct {
+	ID             types.String         `tfsdk:"id"`
+	PackageName    types.String         `tfsdk:"package_name"`
+	File           types.String         `tfsdk:"file"`
+	SHA256         types.String         `tfsdk:"sha256"`
+	SHA1           types.String         `tfsdk:"sha1"`
+	VersionCode    types.Int64          `tfsdk:"version_code"`
+	ExpansionFiles []expansionFileModel `tfsdk:"expansion_file"`
+
+	deobfuscationFilesModel
+
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+type expansionFileModel struct {
+	Type              types.String `tfsdk:"type"`
+	File              types.String `tfsdk:"file"`
+	ReferencesVersion types.Int64  `tfsdk:"references_version"`
+	SHA256            types.String `tfsdk:"sha256"`
+	FileSize          types.Int64  `tfsdk:"file_size"`
+}
+
+func (r *ApkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apk"
+}
+
+func (r *ApkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Upload an APK, and optionally its expansion files, mapping file and native debug symbols, to Google Play.
+		APKs cannot be deleted from Google Play, so destroying this resource only removes it from Terraform state`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the APK, in the format `package_name/version_code`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path to the `.apk` file to upload",
+				Required:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 of the APK binary. A new APK is uploaded whenever the contents of `file` change",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					hashFilePlanModifier(path.Root("file")),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				MarkdownDescription: "The SHA-1 of the APK binary",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_code": schema.Int64Attribute{
+				MarkdownDescription: "The version code of the APK, for use in releases",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"expansion_file": schema.ListNestedBlock{
+				MarkdownDescription: `OBB expansion files for the APK:
+				https://developer.android.com/google/play/expansion-files.
+				Expansion files are attached when the resource is created, so changing them replaces the resource.
+				The APK is not uploaded again unless its contents have also changed: the new expansion files are attached to the existing APK`,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of expansion file, either `main` or `patch`",
+							Required:            true,
+						},
+						"file": schema.StringAttribute{
+							MarkdownDescription: "Path to the expansion file to upload. Conflicts with `references_version`",
+							Optional:            true,
+						},
+						"references_version": schema.Int64Attribute{
+							MarkdownDescription: "Reuse the expansion file of this earlier version code instead of uploading one. Conflicts with `file`",
+							Optional:            true,
+						},
+						"sha256": schema.StringAttribute{
+							MarkdownDescription: "The SHA-256 of the uploaded expansion file",
+							Computed:            true,
+						},
+						"file_size": schema.Int64Attribute{
+							MarkdownDescription: "The size of the expansion file in bytes",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, deobfuscationFileAttributes("APK"))
+}
+
+func (r *ApkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data apkResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, expansion := range data.ExpansionFiles {
+		expansionPath := path.Root("expansion_file").AtListIndex(i)
+
+		if !expansion.Type.IsUnknown() {
+			expansionType := expansion.Type.ValueString()
+			if expansionType != "main" && expansionType != "patch" {
+				resp.Diagnostics.AddAttributeError(
+					expansionPath.AtName("type"),
+					"Invalid expansion file type",
+					fmt.Sprintf("'%s' is not a valid expansion file type, expected one of: %s.", expansionType, strings.Join(expansionFileTypes, ", ")),
+				)
+			}
+			if seen[expansionType] {
+				resp.Diagnostics.AddAttributeError(
+					expansionPath.AtName("type"),
+					"Duplicate expansion file",
+					fmt.Sprintf("An APK can only have one %s expansion file.", expansionType),
+				)
+			}
+			seen[expansionType] = true
+		}
+
+		// Each expansion file is either uploaded or reused from an earlier version
+		if expansion.File.IsNull() == expansion.ReferencesVersion.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				expansionPath,
+				"Invalid expansion file",
+				"Exactly one of file or references_version must be set.",
+			)
+		}
+	}
+}
+
+func (r *ApkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the APK is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan apkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []expansionFileModel
+	if !req.State.Raw.IsNull() {
+		var state apkResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = state.ExpansionFiles
+	}
+
+	for i := range plan.ExpansionFiles {
+		expansion := &plan.ExpansionFiles[i]
+		expansion.SHA256 = types.StringNull()
+		expansion.FileSize = types.Int64Unknown()
+
+		if expansion.File.IsUnknown() {
+			expansion.SHA256 = types.StringUnknown()
+		} else if !expansion.File.IsNull() {
+			hash, err := fileSHA256(expansion.File.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("expansion_file").AtListIndex(i).AtName("file"),
+					"Unable to read file",
+					err.Error(),
+				)
+				return
+			}
+			expansion.SHA256 = types.StringValue(hash)
+		}
+
+		// Keep the size of expansion files which have not changed
+		for _, existing := range prior {
+			if existing.sameAs(*expansion) {
+				expansion.FileSize = existing.FileSize
+			}
+		}
+	}
+
+	// Expansion files are only attached when the APK resource is created
+	if !req.State.Raw.IsNull() && !sameExpansionFiles(prior, plan.ExpansionFiles) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expansion_file"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// sameAs reports whether two expansion files have the same type and contents.
+func (m expansionFileModel) sameAs(other expansionFileModel) bool {
+	return m.Type.Equal(other.Type) &&
+		m.ReferencesVersion.Equal(other.ReferencesVersion) &&
+		m.SHA256.Equal(other.SHA256)
+}
+
+func sameExpansionFiles(a []expansionFileModel, b []expansionFileModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, expansion := range a {
+		found := false
+		for _, other := range b {
+			if expansion.sameAs(other) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ApkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/version_code
+	packageName, versionCode, err := parsePackageVersionCode(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), packageName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version_code"), versionCode)...)
+}
+
+func (r *ApkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data apkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file := data.File.ValueString()
+	sha256, err := fileSHA256(file)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+	if at, err := data.hash(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			at,
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var apk *androidpublisher.Apk
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		var err error
+		apk, err = r.client.FindOrUploadApk(ctx, packageName, editID, file, sha256)
+		if err != nil {
+			return err
+		}
+		if err := r.client.UploadDeobfuscationFiles(ctx, packageName, editID, apk.VersionCode, data.changed(nil)); err != nil {
+			return err
+		}
+
+		for i := range data.ExpansionFiles {
+			expansion := &data.ExpansionFiles[i]
+
+			var expansionFile *androidpublisher.ExpansionFile
+			if expansion.File.IsNull() {
+				expansionFile, err = r.client.ReferenceExpansionFile(
+					ctx, packageName, editID, apk.VersionCode,
+					expansion.Type.ValueString(), expansion.ReferencesVersion.ValueInt64(),
+				)
+			} else {
+				expansionFile, err = r.client.UploadExpansionFile(
+					ctx, packageName, editID, apk.VersionCode,
+					expansion.Type.ValueString(), expansion.File.ValueString(),
+				)
+			}
+			if err != nil {
+				return fmt.Errorf("unable to attach %s expansion file: %w", expansion.Type.ValueString(), err)
+			}
+			expansion.FileSize = types.Int64Value(expansionFile.FileSize)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to upload APK",
+			err.Error(),
+		)
+		return
+	}
+
+	data.setApk(apk)
+	data.SentForReview = types.BoolValue(sentForReview)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data apkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var apk *androidpublisher.Apk
+	err := r.client.ReadEdit(ctx, packageName, func(editID string) error {
+		apks, err := r.client.ListApks(ctx, packageName, editID)
+		if err != nil {
+			return err
+		}
+		apk = findApk(apks, data.SHA256.ValueString())
+
+		// Imported APKs are found by version code, as their hash is not yet
+		// known, and may have either type of expansion file
+		expansions := data.ExpansionFiles
+		if data.SHA256.IsNull() {
+			apk = findApkVersion(apks, data.VersionCode.ValueInt64())
+			expansions = importedExpansionFiles()
+		}
+		if apk == nil {
+			return nil
+		}
+
+		expansionFiles := []expansionFileModel{}
+		for _, expansion := range expansions {
+			expansionFile, err := r.client.GetExpansionFile(ctx, packageName, editID, apk.VersionCode, expansion.Type.ValueString())
+			if isNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			expansion.FileSize = types.Int64Value(expansionFile.FileSize)
+			if expansionFile.ReferencesVersion != 0 {
+				expansion.ReferencesVersion = types.Int64Value(expansionFile.ReferencesVersion)
+			}
+			expansionFiles = append(expansionFiles, expansion)
+		}
+		data.ExpansionFiles = expansionFiles
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch APK",
+			err.Error(),
+		)
+		return
+	}
+
+	// The APK is no longer available in Google Play
+	if apk == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setApk(apk)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state apkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if at, err := data.hash(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			at,
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+
+	// The contents of the APK and its expansion files are the same, so only
+	// deobfuscation files which have changed are uploaded, for the existing
+	// version code
+	data.SentForReview = state.SentForReview
+	if uploads := data.changed(&state.deobfuscationFilesModel); len(uploads) > 0 {
+		packageName := data.PackageName.ValueString()
+		sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+			return r.client.UploadDeobfuscationFiles(ctx, packageName, editID, data.VersionCode.ValueInt64(), uploads)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to upload deobfuscation files",
+				err.Error(),
+			)
+			return
+		}
+		data.SentForReview = types.BoolValue(sentForReview)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// APKs cannot be deleted once uploaded, so the APK is only removed from
+	// Terraform state.
+	tflog.Info(ctx, "APKs cannot be deleted from Google Play, removing from state only")
+}
+
+// importedExpansionFiles lists every type of expansion file, so that an
+// imported APK picks up whichever it has.
+func importedExpansionFiles() []expansionFileModel {
+	expansionFiles := make([]expansionFileModel, len(expansionFileTypes))
+	for i, expansionFileType := range expansionFileTypes {
+		expansionFiles[i] = expansionFileModel{
+			Type:              types.StringValue(expansionFileType),
+			File:              types.StringNull(),
+			ReferencesVersion: types.Int64Null(),
+			SHA256:            types.StringNull(),
+			FileSize:          types.Int64Null(),
+		}
+	}
+	return expansionFiles
+}
+
+func (data *apkResourceModel) setApk(apk *androidpublisher.Apk) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d", data.PackageName.ValueString(), apk.VersionCode))
+	data.VersionCode = types.Int64Value(apk.VersionCode)
+	if apk.Binary != nil {
+		data.SHA256 = types.StringValue(strings.ToLower(apk.Binary.Sha256))
+		data.SHA1 = types.StringValue(strings.ToLower(apk.Binary.Sha1))
+	}
+}
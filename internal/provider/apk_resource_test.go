@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameExpansionFiles(t *testing.T) {
+	main := expansionFileModel{
+		Type:              types.StringValue("main"),
+		ReferencesVersion: types.Int64Null(),
+		SHA256:            types.StringValue("aaaa"),
+	}
+	patch := expansionFileModel{
+		Type:              types.StringValue("patch"),
+		ReferencesVersion: types.Int64Value(10),
+		SHA256:            types.StringNull(),
+	}
+
+	assert.True(t, sameExpansionFiles(nil, nil))
+	assert.True(t, sameExpansionFiles(
+		[]expansionFileModel{main, patch},
+		[]expansionFileModel{patch, main},
+	))
+
+	changed := main
+	changed.SHA256 = types.StringValue("bbbb")
+	assert.False(t, sameExpansionFiles(
+		[]expansionFileModel{main, patch},
+		[]expansionFileModel{changed, patch},
+	))
+	assert.False(t, sameExpansionFiles(
+		[]expansionFileModel{main, patch},
+		[]expansionFileModel{main},
+	))
+
+	// The file path and size do not affect whether the expansion file changed
+	moved := main
+	moved.File = types.StringValue("other/main.obb")
+	moved.FileSize = types.Int64Value(1024)
+	assert.True(t, sameExpansionFiles(
+		[]expansionFileModel{main},
+		[]expansionFileModel{moved},
+	))
+}
+
+func TestImportedExpansionFiles(t *testing.T) {
+	expansionFiles := importedExpansionFiles()
+
+	assert.Len(t, expansionFiles, 2)
+	assert.Equal(t, "main", expansionFiles[0].Type.ValueString())
+	assert.Equal(t, "patch", expansionFiles[1].Type.ValueString())
+	assert.True(t, expansionFiles[0].File.IsNull())
+	assert.True(t, expansionFiles[0].ReferencesVersion.IsNull())
+}
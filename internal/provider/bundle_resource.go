@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &BundleResource{}
+var _ resource.ResourceWithImportState = &BundleResource{}
+
+func NewBundleResource() resource.Resource {
+	return &BundleResource{}
+}
+
+type BundleResource struct {
+	client *GooglePlayClient
+}
+
+type bundleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PackageName types.String `tfsdk:"package_name"`
+	File        types.String `tfsdk:"file"`
+	SHA256      types.String `tfsdk:"sha256"`
+	SHA1        types.String `tfsdk:"sha1"`
+	VersionCode types.Int64  `tfsdk:"version_code"`
+
+	deobfuscationFilesModel
+
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+func (r *BundleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle"
+}
+
+func (r *BundleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Upload an Android App Bundle to Google Play, along with its mapping file and native debug symbols.
+		App bundles cannot be deleted from Google Play, so destroying this resource only removes it from Terraform state`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bundle, in the format `package_name/version_code`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path to the `.aab` file to upload",
+				Required:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 of the bundle. A new bundle is uploaded whenever the contents of `file` change",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					hashFilePlanModifier(path.Root("file")),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				MarkdownDescription: "The SHA-1 of the bundle",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_code": schema.Int64Attribute{
+				MarkdownDescription: "The version code of the bundle, for use in releases",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, deobfuscationFileAttributes("bundle"))
+}
+
+func (r *BundleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/version_code
+	packageName, versionCode, err := parsePackageVersionCode(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), packageName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version_code"), versionCode)...)
+}
+
+// parsePackageVersionCode splits an ID in the format package_name/version_code.
+func parsePackageVersionCode(id string) (string, int64, error) {
+	components := strings.Split(id, "/")
+	if len(components) != 2 {
+		return "", 0, fmt.Errorf("expected an ID in the format 'package_name/version_code', got: %s", id)
+	}
+	versionCode, err := strconv.ParseInt(components[1], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("expected an ID in the format 'package_name/version_code', got: %s", id)
+	}
+	return components[0], versionCode, nil
+}
+
+func (r *BundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data bundleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file := data.File.ValueString()
+	sha256, err := fileSHA256(file)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+	if at, err := data.hash(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			at,
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var bundle *androidpublisher.Bundle
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		var err error
+		bundle, err = r.client.FindOrUploadBundle(ctx, packageName, editID, file, sha256)
+		if err != nil {
+			return err
+		}
+		return r.client.UploadDeobfuscationFiles(ctx, packageName, editID, bundle.VersionCode, data.changed(nil))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to upload bundle",
+			err.Error(),
+		)
+		return
+	}
+
+	data.setBundle(bundle)
+	data.SentForReview = types.BoolValue(sentForReview)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data bundleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var bundles []*androidpublisher.Bundle
+	err := r.client.ReadEdit(ctx, packageName, func(editID string) error {
+		var err error
+		bundles, err = r.client.ListBundles(ctx, packageName, editID)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch bundles",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, bundle := range bundles {
+		// Imported bundles are found by version code, as their hash is not yet known
+		if strings.EqualFold(bundle.Sha256, data.SHA256.ValueString()) ||
+			(data.SHA256.IsNull() && bundle.VersionCode == data.VersionCode.ValueInt64()) {
+			data.setBundle(bundle)
+
+			// Save data into Terraform state
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *BundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state bundleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if at, err := data.hash(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			at,
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+
+	// The contents of the bundle are the same, so only deobfuscation files
+	// which have changed are uploaded, for the existing version code
+	data.SentForReview = state.SentForReview
+	if uploads := data.changed(&state.deobfuscationFilesModel); len(uploads) > 0 {
+		packageName := data.PackageName.ValueString()
+		sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+			return r.client.UploadDeobfuscationFiles(ctx, packageName, editID, data.VersionCode.ValueInt64(), uploads)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to upload deobfuscation files",
+				err.Error(),
+			)
+			return
+		}
+		data.SentForReview = types.BoolValue(sentForReview)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// App bundles cannot be deleted once uploaded, so the bundle is only
+	// removed from Terraform state.
+	tflog.Info(ctx, "app bundles cannot be deleted from Google Play, removing from state only")
+}
+
+func (data *bundleResourceModel) setBundle(bundle *androidpublisher.Bundle) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d", data.PackageName.ValueString(), bundle.VersionCode))
+	data.SHA256 = types.StringValue(strings.ToLower(bundle.Sha256))
+	data.SHA1 = types.StringValue(strings.ToLower(bundle.Sha1))
+	data.VersionCode = types.Int64Value(bundle.VersionCode)
+}
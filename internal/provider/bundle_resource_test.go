@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePackageVersionCode(t *testing.T) {
+	packageName, versionCode, err := parsePackageVersionCode("com.example.app/1042")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "com.example.app", packageName)
+	assert.Equal(t, int64(1042), versionCode)
+}
+
+func TestParsePackageVersionCodeInvalid(t *testing.T) {
+	_, _, err := parsePackageVersionCode("com.example.app")
+	assert.Error(t, err)
+
+	_, _, err = parsePackageVersionCode("com.example.app/latest")
+	assert.Error(t, err)
+}
+
+func bundleHandler(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/bundles") && r.Method == http.MethodGet:
+		_, _ = w.Write([]byte(`{"bundles": []}`))
+	case strings.HasSuffix(r.URL.Path, "/bundles"):
+		_, _ = w.Write([]byte(`{"versionCode": 42, "sha256": "AAAA", "sha1": "BBBB"}`))
+	case strings.Contains(r.URL.Path, "/deobfuscationFiles/"):
+		_, _ = w.Write([]byte(`{"deobfuscationFile": {}}`))
+	default:
+		_, _ = w.Write([]byte(`{"id": "1"}`))
+	}
+}
+
+// testBundle returns a bundle with a mapping file, and writes both to disk.
+func testBundle(t *testing.T) bundleResourceModel {
+	dir := t.TempDir()
+	bundle := filepath.Join(dir, "app.aab")
+	mapping := filepath.Join(dir, "mapping.txt")
+	assert.NoError(t, os.WriteFile(bundle, []byte("bundle"), 0o600))
+	assert.NoError(t, os.WriteFile(mapping, []byte("com.example.A -> a:"), 0o600))
+
+	return bundleResourceModel{
+		ID:          types.StringUnknown(),
+		PackageName: types.StringValue("com.example.app"),
+		File:        types.StringValue(bundle),
+		SHA256:      types.StringUnknown(),
+		SHA1:        types.StringUnknown(),
+		VersionCode: types.Int64Unknown(),
+		deobfuscationFilesModel: deobfuscationFilesModel{
+			MappingFile:              types.StringValue(mapping),
+			MappingFileSHA256:        types.StringUnknown(),
+			NativeDebugSymbols:       types.StringNull(),
+			NativeDebugSymbolsSHA256: types.StringNull(),
+		},
+		ChangesNotSentForReview: types.BoolNull(),
+		SentForReview:           types.BoolUnknown(),
+	}
+}
+
+func TestBundleCreateUploadsMappingFileInSameEdit(t *testing.T) {
+	ctx := context.Background()
+	client, requests := testPublisherClient(t, bundleHandler)
+
+	r := &BundleResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	data := testBundle(t)
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResponse.Schema}}
+	assert.False(t, req.Plan.Set(ctx, &data).HasError())
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	r.Create(ctx, req, resp)
+	assert.Empty(t, diagnosticDetails(resp.Diagnostics))
+
+	assert.Equal(t, []string{
+		"POST /edits",
+		"GET /edits/1/bundles",
+		"POST /upload/androidpublisher/v3/applications/com.example.app/edits/1/bundles",
+		"POST /upload/androidpublisher/v3/applications/com.example.app/edits/1/apks/42/deobfuscationFiles/proguard",
+		"POST /edits/1:commit",
+	}, *requests)
+
+	var state bundleResourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, int64(42), state.VersionCode.ValueInt64())
+	assert.Len(t, state.MappingFileSHA256.ValueString(), 64)
+	assert.True(t, state.NativeDebugSymbolsSHA256.IsNull())
+	assert.True(t, state.SentForReview.ValueBool())
+}
+
+func TestBundleUpdateOnlyUploadsChangedMappingFile(t *testing.T) {
+	ctx := context.Background()
+	client, requests := testPublisherClient(t, bundleHandler)
+
+	r := &BundleResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	state := testBundle(t)
+	state.ID = types.StringValue("com.example.app/42")
+	state.SHA256 = types.StringValue("aaaa")
+	state.SHA1 = types.StringValue("bbbb")
+	state.VersionCode = types.Int64Value(42)
+	state.SentForReview = types.BoolValue(true)
+	_, err := state.hash()
+	assert.NoError(t, err)
+
+	update := func(data bundleResourceModel) bundleResourceModel {
+		req := resource.UpdateRequest{
+			Plan:  tfsdk.Plan{Schema: schemaResponse.Schema},
+			State: tfsdk.State{Schema: schemaResponse.Schema},
+		}
+		assert.False(t, req.Plan.Set(ctx, &data).HasError())
+		assert.False(t, req.State.Set(ctx, &state).HasError())
+		resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+		r.Update(ctx, req, resp)
+		assert.Empty(t, diagnosticDetails(resp.Diagnostics))
+
+		var updated bundleResourceModel
+		assert.False(t, resp.State.Get(ctx, &updated).HasError())
+		return updated
+	}
+
+	// Nothing is uploaded when the contents of the files are the same
+	updated := update(state)
+	assert.Empty(t, *requests)
+	assert.True(t, updated.SentForReview.ValueBool())
+
+	// A changed mapping file is uploaded for the existing version code
+	assert.NoError(t, os.WriteFile(state.MappingFile.ValueString(), []byte("com.example.B -> b:"), 0o600))
+	planned := state
+	planned.ChangesNotSentForReview = types.BoolValue(true)
+	updated = update(planned)
+	assert.Equal(t, []string{
+		"POST /edits",
+		"POST /upload/androidpublisher/v3/applications/com.example.app/edits/1/apks/42/deobfuscationFiles/proguard",
+		"POST /edits/1:commit",
+	}, *requests)
+	assert.NotEqual(t, state.MappingFileSHA256, updated.MappingFileSHA256)
+	assert.False(t, updated.SentForReview.ValueBool())
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+func (c *GooglePlayClient) ListApks(
+	ctx context.Context,
+	packageName string,
+	editID string,
+) ([]*androidpublisher.Apk, error) {
+	resp, err := c.service.Edits.Apks.List(packageName, editID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Apks, nil
+}
+
+func (c *GooglePlayClient) UploadApk(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	path string,
+) (*androidpublisher.Apk, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return c.service.Edits.Apks.Upload(packageName, editID).
+		Media(
+			file,
+			googleapi.ContentType("application/vnd.android.package-archive"),
+			googleapi.ChunkSize(uploadChunkSize),
+		).
+		ProgressUpdater(logUploadProgress(ctx, path)).
+		Context(ctx).
+		Do()
+}
+
+// FindOrUploadApk returns the APK with the given SHA-256 if it has already
+// been uploaded, and otherwise uploads the APK at path.
+func (c *GooglePlayClient) FindOrUploadApk(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	path string,
+	sha256 string,
+) (*androidpublisher.Apk, error) {
+	apks, err := c.ListApks(ctx, packageName, editID)
+	if err != nil {
+		return nil, err
+	}
+
+	if apk := findApk(apks, sha256); apk != nil {
+		tflog.Info(ctx, "APK has already been uploaded", map[string]interface{}{
+			"version_code": apk.VersionCode,
+		})
+		return apk, nil
+	}
+
+	return c.UploadApk(ctx, packageName, editID, path)
+}
+
+func findApk(apks []*androidpublisher.Apk, sha256 string) *androidpublisher.Apk {
+	for _, apk := range apks {
+		if apk.Binary != nil && strings.EqualFold(apk.Binary.Sha256, sha256) {
+			return apk
+		}
+	}
+	return nil
+}
+
+func findApkVersion(apks []*androidpublisher.Apk, versionCode int64) *androidpublisher.Apk {
+	for _, apk := range apks {
+		if apk.VersionCode == versionCode {
+			return apk
+		}
+	}
+	return nil
+}
+
+func (c *GooglePlayClient) GetExpansionFile(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	versionCode int64,
+	expansionFileType string,
+) (*androidpublisher.ExpansionFile, error) {
+	return c.service.Edits.Expansionfiles.Get(packageName, editID, versionCode, expansionFileType).Context(ctx).Do()
+}
+
+func (c *GooglePlayClient) UploadExpansionFile(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	versionCode int64,
+	expansionFileType string,
+	path string,
+) (*androidpublisher.ExpansionFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	resp, err := c.service.Edits.Expansionfiles.Upload(packageName, editID, versionCode, expansionFileType).
+		Media(
+			file,
+			googleapi.ContentType("application/octet-stream"),
+			googleapi.ChunkSize(uploadChunkSize),
+		).
+		ProgressUpdater(logUploadProgress(ctx, path)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.ExpansionFile, nil
+}
+
+// ReferenceExpansionFile makes the APK use the expansion file of an earlier version.
+func (c *GooglePlayClient) ReferenceExpansionFile(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	versionCode int64,
+	expansionFileType string,
+	referencesVersion int64,
+) (*androidpublisher.ExpansionFile, error) {
+	expansionFile := &androidpublisher.ExpansionFile{
+		ReferencesVersion: referencesVersion,
+	}
+	return c.service.Edits.Expansionfiles.Update(packageName, editID, versionCode, expansionFileType, expansionFile).Context(ctx).Do()
+}
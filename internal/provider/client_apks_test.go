@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestFindApk(t *testing.T) {
+	first := &androidpublisher.Apk{Binary: &androidpublisher.ApkBinary{Sha256: "AAAA"}, VersionCode: 1}
+	second := &androidpublisher.Apk{Binary: &androidpublisher.ApkBinary{Sha256: "bbbb"}, VersionCode: 2}
+	missingBinary := &androidpublisher.Apk{VersionCode: 3}
+	apks := []*androidpublisher.Apk{missingBinary, first, second}
+
+	assert.Equal(t, first, findApk(apks, "aaaa"))
+	assert.Equal(t, second, findApk(apks, "bbbb"))
+	assert.Nil(t, findApk(apks, "cccc"))
+}
+
+func TestFindApkVersion(t *testing.T) {
+	first := &androidpublisher.Apk{VersionCode: 1}
+	second := &androidpublisher.Apk{VersionCode: 2}
+	apks := []*androidpublisher.Apk{first, second}
+
+	assert.Equal(t, second, findApkVersion(apks, 2))
+	assert.Nil(t, findApkVersion(apks, 3))
+}
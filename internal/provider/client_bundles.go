@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// uploadChunkSize is the size of each chunk of a resumable upload. Binaries
+// are streamed from disk one chunk at a time, rather than read into memory.
+const uploadChunkSize = 8 * 1024 * 1024
+
+func (c *GooglePlayClient) ListBundles(
+	ctx context.Context,
+	packageName string,
+	editID string,
+) ([]*androidpublisher.Bundle, error) {
+	resp, err := c.service.Edits.Bundles.List(packageName, editID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Bundles, nil
+}
+
+func (c *GooglePlayClient) UploadBundle(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	path string,
+) (*androidpublisher.Bundle, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return c.service.Edits.Bundles.Upload(packageName, editID).
+		Media(
+			file,
+			googleapi.ContentType("application/octet-stream"),
+			googleapi.ChunkSize(uploadChunkSize),
+		).
+		ProgressUpdater(logUploadProgress(ctx, path)).
+		Context(ctx).
+		Do()
+}
+
+// FindOrUploadBundle returns the bundle with the given SHA-256 if it has
+// already been uploaded, and otherwise uploads the bundle at path.
+func (c *GooglePlayClient) FindOrUploadBundle(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	path string,
+	sha256 string,
+) (*androidpublisher.Bundle, error) {
+	bundles, err := c.ListBundles(ctx, packageName, editID)
+	if err != nil {
+		return nil, err
+	}
+
+	if bundle := findBundle(bundles, sha256); bundle != nil {
+		tflog.Info(ctx, "bundle has already been uploaded", map[string]interface{}{
+			"version_code": bundle.VersionCode,
+		})
+		return bundle, nil
+	}
+
+	return c.UploadBundle(ctx, packageName, editID, path)
+}
+
+func findBundle(bundles []*androidpublisher.Bundle, sha256 string) *androidpublisher.Bundle {
+	for _, bundle := range bundles {
+		if strings.EqualFold(bundle.Sha256, sha256) {
+			return bundle
+		}
+	}
+	return nil
+}
+
+func logUploadProgress(ctx context.Context, path string) googleapi.ProgressUpdater {
+	return func(current int64, total int64) {
+		tflog.Debug(ctx, "uploading file", map[string]interface{}{
+			"path":           path,
+			"uploaded_bytes": current,
+			"total_bytes":    total,
+		})
+	}
+}
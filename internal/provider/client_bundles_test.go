@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestFindBundle(t *testing.T) {
+	first := &androidpublisher.Bundle{Sha256: "AAAA", VersionCode: 1}
+	second := &androidpublisher.Bundle{Sha256: "bbbb", VersionCode: 2}
+	bundles := []*androidpublisher.Bundle{first, second}
+
+	assert.Equal(t, first, findBundle(bundles, "aaaa"))
+	assert.Equal(t, second, findBundle(bundles, "bbbb"))
+	assert.Nil(t, findBundle(bundles, "cccc"))
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func (c *GooglePlayClient) GetCountryAvailability(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+) (*androidpublisher.TrackCountryAvailability, error) {
+	return c.service.Edits.Countryavailability.Get(packageName, editID, track).Context(ctx).Do()
+}
+
+// countryCodes returns the sorted country codes targeted by a track.
+func countryCodes(countries []*androidpublisher.TrackTargetedCountry) []string {
+	codes := []string{}
+	for _, country := range countries {
+		codes = append(codes, strings.ToUpper(country.CountryCode))
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// diffCountries returns the expected countries which are not available, and
+// the available countries which were not expected. Both are sorted.
+func diffCountries(expected []string, actual []string) ([]string, []string) {
+	missing := []string{}
+	for _, country := range expected {
+		if !slices.ContainsFunc(actual, func(c string) bool { return strings.EqualFold(c, country) }) {
+			missing = append(missing, strings.ToUpper(country))
+		}
+	}
+	unexpected := []string{}
+	for _, country := range actual {
+		if !slices.ContainsFunc(expected, func(c string) bool { return strings.EqualFold(c, country) }) {
+			unexpected = append(unexpected, strings.ToUpper(country))
+		}
+	}
+	slices.Sort(missing)
+	slices.Sort(unexpected)
+	return missing, unexpected
+}
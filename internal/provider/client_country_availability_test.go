@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestCountryCodes(t *testing.T) {
+	codes := countryCodes([]*androidpublisher.TrackTargetedCountry{
+		{CountryCode: "US"},
+		{CountryCode: "gb"},
+		{CountryCode: "DE"},
+	})
+	assert.Equal(t, []string{"DE", "GB", "US"}, codes)
+	assert.Equal(t, []string{}, countryCodes(nil))
+}
+
+func TestDiffCountries(t *testing.T) {
+	missing, unexpected := diffCountries([]string{"gb", "US"}, []string{"GB", "US"})
+	assert.Empty(t, missing)
+	assert.Empty(t, unexpected)
+
+	missing, unexpected = diffCountries([]string{"GB", "US", "FR"}, []string{"DE", "GB", "CA"})
+	assert.Equal(t, []string{"FR", "US"}, missing)
+	assert.Equal(t, []string{"CA", "DE"}, unexpected)
+}
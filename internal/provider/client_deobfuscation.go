@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+func (c *GooglePlayClient) UploadDeobfuscationFile(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	versionCode int64,
+	deobfuscationFileType string,
+	path string,
+) (*androidpublisher.DeobfuscationFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	resp, err := c.service.Edits.Deobfuscationfiles.Upload(packageName, editID, versionCode, deobfuscationFileType).
+		Media(
+			file,
+			googleapi.ContentType("application/octet-stream"),
+			googleapi.ChunkSize(uploadChunkSize),
+		).
+		ProgressUpdater(logUploadProgress(ctx, path)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.DeobfuscationFile, nil
+}
+
+// UploadDeobfuscationFiles uploads the files in paths, keyed by deobfuscation
+// file type, for the APK or bundle with the given version code.
+func (c *GooglePlayClient) UploadDeobfuscationFiles(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	versionCode int64,
+	paths map[string]string,
+) error {
+	for _, deobfuscationFileType := range slices.Sorted(maps.Keys(paths)) {
+		_, err := c.UploadDeobfuscationFile(ctx, packageName, editID, versionCode, deobfuscationFileType, paths[deobfuscationFileType])
+		if err != nil {
+			return fmt.Errorf("unable to upload %s deobfuscation file: %w", deobfuscationFileType, err)
+		}
+	}
+	return nil
+}
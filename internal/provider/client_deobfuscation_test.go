@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadDeobfuscationFile(t *testing.T) {
+	var uploaded string
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		uploaded = string(body)
+		_, _ = w.Write([]byte(`{"deobfuscationFile": {"symbolType": "proguard"}}`))
+	})
+
+	file := filepath.Join(t.TempDir(), "mapping.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("com.example.A -> a:"), 0o600))
+
+	deobfuscationFile, err := client.UploadDeobfuscationFile(context.Background(), "com.example.app", "1", 42, "proguard", file)
+	assert.NoError(t, err)
+	assert.Equal(t, "proguard", deobfuscationFile.SymbolType)
+	assert.True(t, strings.Contains(uploaded, "com.example.A -> a:"))
+	assert.Equal(t, []string{
+		"POST /upload/androidpublisher/v3/applications/com.example.app/edits/1/apks/42/deobfuscationFiles/proguard",
+	}, *requests)
+}
+
+func TestUploadDeobfuscationFileMissing(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	_, err := client.UploadDeobfuscationFile(context.Background(), "com.example.app", "1", 42, "proguard", filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+	assert.Empty(t, *requests)
+}
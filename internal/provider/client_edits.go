@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// editSessions serialises access to the edits API for each package.
+//
+// Committing an edit invalidates every other edit that is open for the same
+// app, so resources that share a package must take turns rather than opening
+// edits concurrently.
+type editSessions struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (s *editSessions) lock(packageName string) func() {
+	s.mu.Lock()
+	if s.locks == nil {
+		s.locks = map[string]*sync.Mutex{}
+	}
+	lock, ok := s.locks[packageName]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.locks[packageName] = lock
+	}
+	s.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// WithEdit opens an edit for the package, applies the changes made by fn and
+// commits them. The edit is discarded if fn returns an error.
+//
+// In validate only mode the edit is validated and then discarded, so Google
+// Play reports any problems without the changes being published.
+func (c *GooglePlayClient) WithEdit(
+	ctx context.Context,
+	packageName string,
+	fn func(editID string) error,
+) error {
+	_, err := c.WithReviewedEdit(ctx, packageName, nil, fn)
+	return err
+}
+
+// WithReviewedEdit works like WithEdit, and reports whether the committed
+// changes were sent for review. changesNotSentForReview overrides the
+// provider's changes_not_sent_for_review setting when it is not nil.
+func (c *GooglePlayClient) WithReviewedEdit(
+	ctx context.Context,
+	packageName string,
+	changesNotSentForReview *bool,
+	fn func(editID string) error,
+) (bool, error) {
+	unlock := c.edits.lock(packageName)
+	defer unlock()
+
+	edit, err := c.service.Edits.Insert(packageName, &androidpublisher.AppEdit{}).Context(ctx).Do()
+	if err != nil {
+		return false, err
+	}
+
+	if err := fn(edit.Id); err != nil {
+		c.discardEdit(ctx, packageName, edit.Id)
+		return false, err
+	}
+
+	if c.validateOnly {
+		return false, c.validateEdit(ctx, packageName, edit.Id)
+	}
+
+	notSentForReview := c.changesNotSentForReview
+	if changesNotSentForReview != nil {
+		notSentForReview = *changesNotSentForReview
+	}
+
+	commit := c.service.Edits.Commit(packageName, edit.Id)
+	if notSentForReview {
+		commit = commit.ChangesNotSentForReview(true)
+	}
+	if _, err := commit.Context(ctx).Do(); err != nil {
+		c.discardEdit(ctx, packageName, edit.Id)
+		return false, explainReviewError(err)
+	}
+	return !notSentForReview, nil
+}
+
+// explainReviewError adds advice to the errors Google Play returns when
+// changes_not_sent_for_review does not suit the app's review state.
+func explainReviewError(err error) error {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) || !strings.Contains(apiErr.Message, "changesNotSentForReview") {
+		return err
+	}
+
+	if strings.Contains(apiErr.Message, "must not be set") {
+		return fmt.Errorf(
+			"%w\n\nGoogle Play sends changes to this app for review automatically. "+
+				"Remove changes_not_sent_for_review, or set it to false, on the provider and this resource",
+			err,
+		)
+	}
+	return fmt.Errorf(
+		"%w\n\nGoogle Play cannot send these changes for review automatically, usually because an earlier update was rejected. "+
+			"Set changes_not_sent_for_review = true on the provider or this resource, "+
+			"then send the changes for review from the Publishing overview in Play Console",
+		err,
+	)
+}
+
+// validateEdit checks that the edit could be committed, and then discards it.
+func (c *GooglePlayClient) validateEdit(ctx context.Context, packageName string, editID string) error {
+	defer c.discardEdit(ctx, packageName, editID)
+
+	_, err := c.service.Edits.Validate(packageName, editID).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("edit failed validation: %w", err)
+	}
+
+	tflog.Info(ctx, "validated edit without committing it", map[string]interface{}{
+		"package_name": packageName,
+		"edit_id":      editID,
+	})
+	return nil
+}
+
+// ReadEdit opens an edit for the package so that fn can read from it, and
+// discards the edit afterwards.
+func (c *GooglePlayClient) ReadEdit(
+	ctx context.Context,
+	packageName string,
+	fn func(editID string) error,
+) error {
+	unlock := c.edits.lock(packageName)
+	defer unlock()
+
+	edit, err := c.service.Edits.Insert(packageName, &androidpublisher.AppEdit{}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	defer c.discardEdit(ctx, packageName, edit.Id)
+
+	return fn(edit.Id)
+}
+
+func (c *GooglePlayClient) discardEdit(ctx context.Context, packageName string, editID string) {
+	err := c.service.Edits.Delete(packageName, editID).Context(ctx).Do()
+	if err != nil {
+		tflog.Warn(ctx, "failed to discard edit", map[string]interface{}{
+			"package_name": packageName,
+			"edit_id":      editID,
+			"error":        err.Error(),
+		})
+	}
+}
+
+// isNotFound reports whether err is a Google API error for a missing resource.
+func isNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// testPublisherClient returns a client backed by a fake Android Publisher API,
+// which records the method and path of each request it receives.
+func testPublisherClient(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*GooglePlayClient, *[]string) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/androidpublisher/v3/applications/com.example.app")
+		requests = append(requests, r.Method+" "+path)
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	service, err := androidpublisher.NewService(
+		context.Background(),
+		option.WithEndpoint(server.URL),
+		option.WithoutAuthentication(),
+	)
+	assert.NoError(t, err)
+
+	return &GooglePlayClient{service: service}, &requests
+}
+
+func editsHandler(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasSuffix(r.URL.Path, ":validate"):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": {"code": 400, "message": "Release notes are too long."}}`))
+	default:
+		_, _ = w.Write([]byte(`{"id": "1"}`))
+	}
+}
+
+func TestWithEditCommits(t *testing.T) {
+	client, requests := testPublisherClient(t, editsHandler)
+
+	err := client.WithEdit(context.Background(), "com.example.app", func(editID string) error {
+		assert.Equal(t, "1", editID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /edits", "POST /edits/1:commit"}, *requests)
+}
+
+func TestWithEditValidateOnly(t *testing.T) {
+	client, requests := testPublisherClient(t, editsHandler)
+	client.validateOnly = true
+
+	err := client.WithEdit(context.Background(), "com.example.app", func(editID string) error {
+		return nil
+	})
+	assert.ErrorContains(t, err, "Release notes are too long.")
+	assert.Equal(t, []string{"POST /edits", "POST /edits/1:validate", "DELETE /edits/1"}, *requests)
+}
+
+func TestWithReviewedEdit(t *testing.T) {
+	var notSentForReview []string
+	client, _ := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, ":commit") {
+			notSentForReview = append(notSentForReview, r.URL.Query().Get("changesNotSentForReview"))
+		}
+		editsHandler(w, r)
+	})
+	noop := func(editID string) error { return nil }
+
+	sentForReview, err := client.WithReviewedEdit(context.Background(), "com.example.app", nil, noop)
+	assert.NoError(t, err)
+	assert.True(t, sentForReview)
+
+	// The provider setting applies unless the resource overrides it
+	client.changesNotSentForReview = true
+	sentForReview, err = client.WithReviewedEdit(context.Background(), "com.example.app", nil, noop)
+	assert.NoError(t, err)
+	assert.False(t, sentForReview)
+
+	override := false
+	sentForReview, err = client.WithReviewedEdit(context.Background(), "com.example.app", &override, noop)
+	assert.NoError(t, err)
+	assert.True(t, sentForReview)
+
+	assert.Equal(t, []string{"", "true", ""}, notSentForReview)
+}
+
+func TestExplainReviewError(t *testing.T) {
+	other := &googleapi.Error{Code: 400, Message: "Release notes are too long."}
+	assert.Equal(t, other, explainReviewError(other))
+
+	required := &googleapi.Error{
+		Code:    400,
+		Message: "Changes cannot be sent for review automatically. Please set the query parameter changesNotSentForReview to true.",
+	}
+	err := explainReviewError(required)
+	assert.ErrorIs(t, err, required)
+	assert.ErrorContains(t, err, "Set changes_not_sent_for_review = true")
+
+	forbidden := &googleapi.Error{
+		Code:    400,
+		Message: "Changes are sent for review automatically. The query parameter changesNotSentForReview must not be set.",
+	}
+	err = explainReviewError(forbidden)
+	assert.ErrorIs(t, err, forbidden)
+	assert.ErrorContains(t, err, "Remove changes_not_sent_for_review")
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func (c *GooglePlayClient) ListImages(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	language string,
+	imageType ImageType,
+) ([]*androidpublisher.Image, error) {
+	resp, err := c.service.Edits.Images.List(packageName, editID, language, string(imageType)).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Images, nil
+}
+
+func (c *GooglePlayClient) UploadImage(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	language string,
+	imageType ImageType,
+	path string,
+) (*androidpublisher.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	resp, err := c.service.Edits.Images.Upload(packageName, editID, language, string(imageType)).
+		Media(file).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Image, nil
+}
+
+func (c *GooglePlayClient) DeleteImage(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	language string,
+	imageType ImageType,
+	imageID string,
+) error {
+	return c.service.Edits.Images.Delete(packageName, editID, language, string(imageType), imageID).Context(ctx).Do()
+}
+
+func (c *GooglePlayClient) DeleteAllImages(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	language string,
+	imageType ImageType,
+) error {
+	_, err := c.service.Edits.Images.Deleteall(packageName, editID, language, string(imageType)).Context(ctx).Do()
+	return err
+}
+
+// SyncImages makes the images of the given type match the files at paths,
+// in order. hashes holds the SHA-256 of each file.
+//
+// Google Play has no way to reorder images, so images which already match the
+// start of the list are kept and everything after the first difference is
+// replaced.
+func (c *GooglePlayClient) SyncImages(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	language string,
+	imageType ImageType,
+	paths []string,
+	hashes []string,
+) ([]*androidpublisher.Image, error) {
+	existing, err := c.ListImages(ctx, packageName, editID, language, imageType)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := make([]string, len(existing))
+	for i, image := range existing {
+		remote[i] = image.Sha256
+	}
+	keep := matchingImagePrefix(remote, hashes)
+
+	for _, image := range existing[keep:] {
+		err := c.DeleteImage(ctx, packageName, editID, language, imageType, image.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	images := existing[:keep]
+	for _, path := range paths[keep:] {
+		image, err := c.UploadImage(ctx, packageName, editID, language, imageType, path)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+// matchingImagePrefix returns how many leading hashes are the same in both lists.
+func matchingImagePrefix(remote []string, local []string) int {
+	count := 0
+	for count < len(remote) && count < len(local) {
+		if !strings.EqualFold(remote[count], local[count]) {
+			break
+		}
+		count++
+	}
+	return count
+}
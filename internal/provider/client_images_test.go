@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchingImagePrefixUnchanged(t *testing.T) {
+	assert.Equal(
+		t,
+		2,
+		matchingImagePrefix([]string{"a", "b"}, []string{"a", "b"}),
+	)
+}
+
+func TestMatchingImagePrefixIgnoresCase(t *testing.T) {
+	assert.Equal(
+		t,
+		1,
+		matchingImagePrefix([]string{"ABC"}, []string{"abc"}),
+	)
+}
+
+func TestMatchingImagePrefixAppended(t *testing.T) {
+	assert.Equal(
+		t,
+		2,
+		matchingImagePrefix([]string{"a", "b"}, []string{"a", "b", "c"}),
+	)
+}
+
+func TestMatchingImagePrefixRemoved(t *testing.T) {
+	assert.Equal(
+		t,
+		1,
+		matchingImagePrefix([]string{"a", "b", "c"}, []string{"a", "c"}),
+	)
+}
+
+func TestMatchingImagePrefixReordered(t *testing.T) {
+	assert.Equal(
+		t,
+		0,
+		matchingImagePrefix([]string{"a", "b"}, []string{"b", "a"}),
+	)
+}
+
+func TestMatchingImagePrefixEmpty(t *testing.T) {
+	assert.Equal(
+		t,
+		0,
+		matchingImagePrefix([]string{}, []string{"a"}),
+	)
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func (c *GooglePlayClient) GetInAppProduct(
+	ctx context.Context,
+	packageName string,
+	sku string,
+) (*androidpublisher.InAppProduct, error) {
+	return c.service.Inappproducts.Get(packageName, sku).Context(ctx).Do()
+}
+
+func (c *GooglePlayClient) InsertInAppProduct(
+	ctx context.Context,
+	product *androidpublisher.InAppProduct,
+	autoConvertMissingPrices bool,
+) (*androidpublisher.InAppProduct, error) {
+	return c.service.Inappproducts.Insert(product.PackageName, product).
+		AutoConvertMissingPrices(autoConvertMissingPrices).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) PatchInAppProduct(
+	ctx context.Context,
+	product *androidpublisher.InAppProduct,
+	autoConvertMissingPrices bool,
+) (*androidpublisher.InAppProduct, error) {
+	return c.service.Inappproducts.Patch(product.PackageName, product.Sku, product).
+		AutoConvertMissingPrices(autoConvertMissingPrices).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) DeleteInAppProduct(
+	ctx context.Context,
+	packageName string,
+	sku string,
+) error {
+	return c.service.Inappproducts.Delete(packageName, sku).Context(ctx).Do()
+}
+
+// inAppProductBatchSize is the most products a single batch request can change.
+const inAppProductBatchSize = 100
+
+// ListInAppProducts returns every in-app product of the app.
+func (c *GooglePlayClient) ListInAppProducts(
+	ctx context.Context,
+	packageName string,
+) ([]*androidpublisher.InAppProduct, error) {
+	products := []*androidpublisher.InAppProduct{}
+	token := ""
+	for {
+		call := c.service.Inappproducts.List(packageName).Context(ctx)
+		if token != "" {
+			call = call.Token(token)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, resp.Inappproduct...)
+
+		if resp.TokenPagination == nil || resp.TokenPagination.NextPageToken == "" {
+			return products, nil
+		}
+		token = resp.TokenPagination.NextPageToken
+	}
+}
+
+// UpdateInAppProduct creates or replaces a product.
+func (c *GooglePlayClient) UpdateInAppProduct(
+	ctx context.Context,
+	product *androidpublisher.InAppProduct,
+	autoConvertMissingPrices bool,
+) (*androidpublisher.InAppProduct, error) {
+	return c.service.Inappproducts.Update(product.PackageName, product.Sku, product).
+		AllowMissing(true).
+		AutoConvertMissingPrices(autoConvertMissingPrices).
+		Context(ctx).
+		Do()
+}
+
+// BatchUpdateInAppProducts creates or replaces products in batches. If a
+// batch fails, its products are retried one at a time so that the products
+// which cannot be saved are reported individually, keyed by SKU.
+func (c *GooglePlayClient) BatchUpdateInAppProducts(
+	ctx context.Context,
+	packageName string,
+	products []*androidpublisher.InAppProduct,
+	autoConvertMissingPrices bool,
+) ([]*androidpublisher.InAppProduct, map[string]error) {
+	updated := []*androidpublisher.InAppProduct{}
+	failures := map[string]error{}
+
+	for batch := range slices.Chunk(products, inAppProductBatchSize) {
+		request := &androidpublisher.InappproductsBatchUpdateRequest{}
+		for _, product := range batch {
+			request.Requests = append(request.Requests, &androidpublisher.InappproductsUpdateRequest{
+				PackageName:              packageName,
+				Sku:                      product.Sku,
+				Inappproduct:             product,
+				AllowMissing:             true,
+				AutoConvertMissingPrices: autoConvertMissingPrices,
+			})
+		}
+
+		resp, err := c.service.Inappproducts.BatchUpdate(packageName, request).Context(ctx).Do()
+		if err == nil {
+			updated = append(updated, resp.Inappproducts...)
+			continue
+		}
+
+		tflog.Warn(ctx, "batch update of in-app products failed, retrying individually", map[string]interface{}{
+			"error": err.Error(),
+		})
+		for _, product := range batch {
+			result, err := c.UpdateInAppProduct(ctx, product, autoConvertMissingPrices)
+			if err != nil {
+				failures[product.Sku] = err
+				continue
+			}
+			updated = append(updated, result)
+		}
+	}
+	return updated, failures
+}
+
+// BatchDeleteInAppProducts deletes products in batches, reporting the
+// products which cannot be deleted keyed by SKU.
+func (c *GooglePlayClient) BatchDeleteInAppProducts(
+	ctx context.Context,
+	packageName string,
+	skus []string,
+) map[string]error {
+	failures := map[string]error{}
+
+	for batch := range slices.Chunk(skus, inAppProductBatchSize) {
+		request := &androidpublisher.InappproductsBatchDeleteRequest{}
+		for _, sku := range batch {
+			request.Requests = append(request.Requests, &androidpublisher.InappproductsDeleteRequest{
+				PackageName: packageName,
+				Sku:         sku,
+			})
+		}
+
+		err := c.service.Inappproducts.BatchDelete(packageName, request).Context(ctx).Do()
+		if err == nil {
+			continue
+		}
+
+		tflog.Warn(ctx, "batch delete of in-app products failed, retrying individually", map[string]interface{}{
+			"error": err.Error(),
+		})
+		for _, sku := range batch {
+			err := c.DeleteInAppProduct(ctx, packageName, sku)
+			if err != nil && !isNotFound(err) {
+				failures[sku] = err
+			}
+		}
+	}
+	return failures
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestBatchUpdateInAppProductsReportsEachFailure(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, ":batchUpdate"):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": {"code": 400, "message": "Invalid product."}}`))
+		case strings.HasSuffix(r.URL.Path, "/broken"):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": {"code": 400, "message": "Price is too low."}}`))
+		default:
+			sku := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			_, _ = fmt.Fprintf(w, `{"sku": %q}`, sku)
+		}
+	})
+
+	products := []*androidpublisher.InAppProduct{
+		{PackageName: "com.example.app", Sku: "coins"},
+		{PackageName: "com.example.app", Sku: "broken"},
+	}
+	updated, failures := client.BatchUpdateInAppProducts(context.Background(), "com.example.app", products, false)
+
+	assert.Len(t, updated, 1)
+	assert.Equal(t, "coins", updated[0].Sku)
+	assert.Len(t, failures, 1)
+	assert.ErrorContains(t, failures["broken"], "Price is too low.")
+	assert.Equal(t, []string{
+		"POST /inappproducts:batchUpdate",
+		"PUT /inappproducts/coins",
+		"PUT /inappproducts/broken",
+	}, *requests)
+}
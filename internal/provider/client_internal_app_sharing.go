@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// isBundle reports whether the file at path is an Android App Bundle, rather
+// than an APK.
+func isBundle(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".aab")
+}
+
+// UploadInternalAppSharingArtifact uploads the bundle or APK at path to
+// internal app sharing.
+func (c *GooglePlayClient) UploadInternalAppSharingArtifact(
+	ctx context.Context,
+	packageName string,
+	path string,
+) (*androidpublisher.InternalAppSharingArtifact, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if isBundle(path) {
+		return c.service.Internalappsharingartifacts.Uploadbundle(packageName).
+			Media(
+				file,
+				googleapi.ContentType("application/octet-stream"),
+				googleapi.ChunkSize(uploadChunkSize),
+			).
+			ProgressUpdater(logUploadProgress(ctx, path)).
+			Context(ctx).
+			Do()
+	}
+
+	return c.service.Internalappsharingartifacts.Uploadapk(packageName).
+		Media(
+			file,
+			googleapi.ContentType("application/vnd.android.package-archive"),
+			googleapi.ChunkSize(uploadChunkSize),
+		).
+		ProgressUpdater(logUploadProgress(ctx, path)).
+		Context(ctx).
+		Do()
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadInternalAppSharingArtifact(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"downloadUrl": "https://play.google.com/apps/test/abc", "certificateFingerprint": "AB:CD", "sha256": "ABCD"}`))
+	})
+
+	dir := t.TempDir()
+	for _, name := range []string{"app.aab", "app.apk"} {
+		file := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(file, []byte("build"), 0o600))
+
+		artifact, err := client.UploadInternalAppSharingArtifact(context.Background(), "com.example.app", file)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://play.google.com/apps/test/abc", artifact.DownloadUrl)
+		assert.Equal(t, "AB:CD", artifact.CertificateFingerprint)
+	}
+
+	assert.Equal(t, []string{
+		"POST /upload/androidpublisher/v3/applications/internalappsharing/com.example.app/artifacts/bundle",
+		"POST /upload/androidpublisher/v3/applications/internalappsharing/com.example.app/artifacts/apk",
+	}, *requests)
+}
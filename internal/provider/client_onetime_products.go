@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// oneTimeProductOfferUpdateMask lists the fields of a one-time product offer
+// that can be changed.
+const oneTimeProductOfferUpdateMask = "discountedOffer,offerTags,regionalPricingAndAvailabilityConfigs"
+
+// allPurchaseOptions is used in place of a purchase option ID to read or
+// change offers across every purchase option of a product.
+const allPurchaseOptions = "-"
+
+func (c *GooglePlayClient) GetOneTimeProduct(
+	ctx context.Context,
+	packageName string,
+	productID string,
+) (*androidpublisher.OneTimeProduct, error) {
+	return c.service.Monetization.Onetimeproducts.Get(packageName, productID).Context(ctx).Do()
+}
+
+// SaveOneTimeProduct saves the fields of product listed in updateMask. The API
+// has no separate create call, so the product is created if it does not exist.
+// New purchase options are saved as drafts.
+func (c *GooglePlayClient) SaveOneTimeProduct(
+	ctx context.Context,
+	product *androidpublisher.OneTimeProduct,
+	updateMask []string,
+	regionsVersion string,
+) (*androidpublisher.OneTimeProduct, error) {
+	return c.service.Monetization.Onetimeproducts.Patch(product.PackageName, product.ProductId, product).
+		AllowMissing(true).
+		UpdateMask(strings.Join(updateMask, ",")).
+		RegionsVersionVersion(regionsVersion).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) DeleteOneTimeProduct(
+	ctx context.Context,
+	packageName string,
+	productID string,
+) error {
+	return c.service.Monetization.Onetimeproducts.Delete(packageName, productID).Context(ctx).Do()
+}
+
+// DeletePurchaseOptions deletes purchase options of a product, along with
+// their offers.
+func (c *GooglePlayClient) DeletePurchaseOptions(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	purchaseOptionIDs []string,
+) error {
+	if len(purchaseOptionIDs) == 0 {
+		return nil
+	}
+
+	request := &androidpublisher.BatchDeletePurchaseOptionsRequest{}
+	for _, purchaseOptionID := range purchaseOptionIDs {
+		request.Requests = append(request.Requests, &androidpublisher.DeletePurchaseOptionRequest{
+			PackageName:      packageName,
+			ProductId:        productID,
+			PurchaseOptionId: purchaseOptionID,
+			Force:            true,
+		})
+	}
+	return c.service.Monetization.Onetimeproducts.PurchaseOptions.BatchDelete(packageName, productID, request).Context(ctx).Do()
+}
+
+// UpdatePurchaseOptionStates activates and deactivates purchase options of a
+// product, and returns the updated product.
+func (c *GooglePlayClient) UpdatePurchaseOptionStates(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	activate []string,
+	deactivate []string,
+) (*androidpublisher.OneTimeProduct, error) {
+	request := &androidpublisher.BatchUpdatePurchaseOptionStatesRequest{}
+	for _, purchaseOptionID := range activate {
+		request.Requests = append(request.Requests, &androidpublisher.UpdatePurchaseOptionStateRequest{
+			ActivatePurchaseOptionRequest: &androidpublisher.ActivatePurchaseOptionRequest{
+				PackageName:      packageName,
+				ProductId:        productID,
+				PurchaseOptionId: purchaseOptionID,
+			},
+		})
+	}
+	for _, purchaseOptionID := range deactivate {
+		request.Requests = append(request.Requests, &androidpublisher.UpdatePurchaseOptionStateRequest{
+			DeactivatePurchaseOptionRequest: &androidpublisher.DeactivatePurchaseOptionRequest{
+				PackageName:      packageName,
+				ProductId:        productID,
+				PurchaseOptionId: purchaseOptionID,
+			},
+		})
+	}
+
+	response, err := c.service.Monetization.Onetimeproducts.PurchaseOptions.BatchUpdateStates(packageName, productID, request).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if len(response.OneTimeProducts) == 0 {
+		return c.GetOneTimeProduct(ctx, packageName, productID)
+	}
+	return response.OneTimeProducts[len(response.OneTimeProducts)-1], nil
+}
+
+// ListOneTimeProductOffers returns the offers of every purchase option of a
+// product.
+func (c *GooglePlayClient) ListOneTimeProductOffers(
+	ctx context.Context,
+	packageName string,
+	productID string,
+) ([]*androidpublisher.OneTimeProductOffer, error) {
+	offers := []*androidpublisher.OneTimeProductOffer{}
+	err := c.service.Monetization.Onetimeproducts.PurchaseOptions.Offers.List(packageName, productID, allPurchaseOptions).
+		PageSize(1000).
+		Pages(ctx, func(response *androidpublisher.ListOneTimeProductOffersResponse) error {
+			offers = append(offers, response.OneTimeProductOffers...)
+			return nil
+		})
+	return offers, err
+}
+
+// SaveOneTimeProductOffers creates offers, or replaces those which already
+// exist. New offers are saved as drafts.
+func (c *GooglePlayClient) SaveOneTimeProductOffers(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	offers []*androidpublisher.OneTimeProductOffer,
+	regionsVersion string,
+) ([]*androidpublisher.OneTimeProductOffer, error) {
+	if len(offers) == 0 {
+		return []*androidpublisher.OneTimeProductOffer{}, nil
+	}
+
+	request := &androidpublisher.BatchUpdateOneTimeProductOffersRequest{}
+	for _, offer := range offers {
+		request.Requests = append(request.Requests, &androidpublisher.UpdateOneTimeProductOfferRequest{
+			OneTimeProductOffer: offer,
+			AllowMissing:        true,
+			UpdateMask:          oneTimeProductOfferUpdateMask,
+			RegionsVersion:      &androidpublisher.RegionsVersion{Version: regionsVersion},
+		})
+	}
+
+	response, err := c.service.Monetization.Onetimeproducts.PurchaseOptions.Offers.
+		BatchUpdate(packageName, productID, allPurchaseOptions, request).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	return response.OneTimeProductOffers, nil
+}
+
+// DeleteOneTimeProductOffers deletes offers, which may belong to different
+// purchase options of the product.
+func (c *GooglePlayClient) DeleteOneTimeProductOffers(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	offers []*androidpublisher.OneTimeProductOffer,
+) error {
+	if len(offers) == 0 {
+		return nil
+	}
+
+	request := &androidpublisher.BatchDeleteOneTimeProductOffersRequest{}
+	for _, offer := range offers {
+		request.Requests = append(request.Requests, &androidpublisher.DeleteOneTimeProductOfferRequest{
+			PackageName:      packageName,
+			ProductId:        productID,
+			PurchaseOptionId: offer.PurchaseOptionId,
+			OfferId:          offer.OfferId,
+		})
+	}
+	return c.service.Monetization.Onetimeproducts.PurchaseOptions.Offers.
+		BatchDelete(packageName, productID, allPurchaseOptions, request).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) ActivateOneTimeProductOffer(
+	ctx context.Context,
+	offer *androidpublisher.OneTimeProductOffer,
+) (*androidpublisher.OneTimeProductOffer, error) {
+	return c.service.Monetization.Onetimeproducts.PurchaseOptions.Offers.
+		Activate(offer.PackageName, offer.ProductId, offer.PurchaseOptionId, offer.OfferId, &androidpublisher.ActivateOneTimeProductOfferRequest{
+			PackageName:      offer.PackageName,
+			ProductId:        offer.ProductId,
+			PurchaseOptionId: offer.PurchaseOptionId,
+			OfferId:          offer.OfferId,
+		}).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) DeactivateOneTimeProductOffer(
+	ctx context.Context,
+	offer *androidpublisher.OneTimeProductOffer,
+) (*androidpublisher.OneTimeProductOffer, error) {
+	return c.service.Monetization.Onetimeproducts.PurchaseOptions.Offers.
+		Deactivate(offer.PackageName, offer.ProductId, offer.PurchaseOptionId, offer.OfferId, &androidpublisher.DeactivateOneTimeProductOfferRequest{
+			PackageName:      offer.PackageName,
+			ProductId:        offer.ProductId,
+			PurchaseOptionId: offer.PurchaseOptionId,
+			OfferId:          offer.OfferId,
+		}).
+		Context(ctx).
+		Do()
+}
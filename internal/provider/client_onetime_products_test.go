@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestUpdatePurchaseOptionStatesFetchesProduct(t *testing.T) {
+	var request androidpublisher.BatchUpdatePurchaseOptionStatesRequest
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &request))
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"productId": "coins", "purchaseOptions": [{"purchaseOptionId": "buy", "state": "ACTIVE"}]}`))
+	})
+
+	product, err := client.UpdatePurchaseOptionStates(context.Background(), "com.example.app", "coins", []string{"buy"}, []string{"rent"})
+	assert.NoError(t, err)
+	assert.Equal(t, "ACTIVE", product.PurchaseOptions[0].State)
+	assert.Len(t, request.Requests, 2)
+	assert.Equal(t, "buy", request.Requests[0].ActivatePurchaseOptionRequest.PurchaseOptionId)
+	assert.Equal(t, "rent", request.Requests[1].DeactivatePurchaseOptionRequest.PurchaseOptionId)
+	assert.Equal(t, []string{
+		"POST /oneTimeProducts/coins/purchaseOptions:batchUpdateStates",
+		"GET /oneTimeProducts/coins",
+	}, *requests)
+}
+
+func TestSaveOneTimeProductOffersAcrossPurchaseOptions(t *testing.T) {
+	var request androidpublisher.BatchUpdateOneTimeProductOffersRequest
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &request))
+		_, _ = w.Write([]byte(`{"oneTimeProductOffers": [{"offerId": "sale", "state": "DRAFT"}]}`))
+	})
+
+	offers, err := client.SaveOneTimeProductOffers(context.Background(), "com.example.app", "coins", []*androidpublisher.OneTimeProductOffer{
+		{PurchaseOptionId: "buy", OfferId: "sale"},
+	}, defaultRegionsVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, "DRAFT", offers[0].State)
+	assert.True(t, request.Requests[0].AllowMissing)
+	assert.Equal(t, defaultRegionsVersion, request.Requests[0].RegionsVersion.Version)
+	assert.Equal(t, []string{
+		"POST /oneTimeProducts/coins/purchaseOptions/-/offers:batchUpdate",
+	}, *requests)
+
+	offers, err = client.SaveOneTimeProductOffers(context.Background(), "com.example.app", "coins", nil, defaultRegionsVersion)
+	assert.NoError(t, err)
+	assert.Empty(t, offers)
+	assert.Len(t, *requests, 1)
+}
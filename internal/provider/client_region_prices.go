@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// ConvertRegionPrices converts price into the local currency of every region
+// where Google Play sells products, keyed by region code.
+func (c *GooglePlayClient) ConvertRegionPrices(
+	ctx context.Context,
+	packageName string,
+	price *androidpublisher.Money,
+) (map[string]*androidpublisher.Money, error) {
+	resp, err := c.service.Monetization.ConvertRegionPrices(packageName, &androidpublisher.ConvertRegionPricesRequest{
+		Price: price,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	prices := map[string]*androidpublisher.Money{}
+	for region, converted := range resp.ConvertedRegionPrices {
+		if converted.Price != nil {
+			prices[region] = converted.Price
+		}
+	}
+	return prices, nil
+}
+
+// regionPriceCache remembers converted prices for the life of the provider
+// process. Terraform starts a new process for each plan and apply, so every
+// call with the same price during one apply returns the same result.
+type regionPriceCache struct {
+	mu     sync.Mutex
+	client *GooglePlayClient
+	prices map[string]map[string]*androidpublisher.Money
+}
+
+var regionPrices = &regionPriceCache{}
+
+func (c *regionPriceCache) convert(
+	ctx context.Context,
+	packageName string,
+	price *androidpublisher.Money,
+) (map[string]*androidpublisher.Money, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%d/%d/%s", packageName, price.Units, price.Nanos, price.CurrencyCode)
+	if prices, ok := c.prices[key]; ok {
+		return prices, nil
+	}
+
+	// Provider functions cannot read the provider configuration, so they use
+	// the application default credentials
+	if c.client == nil {
+		service, err := androidpublisher.NewService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.client = &GooglePlayClient{service: service}
+	}
+
+	prices, err := c.client.ConvertRegionPrices(ctx, packageName, price)
+	if err != nil {
+		return nil, err
+	}
+	if c.prices == nil {
+		c.prices = map[string]map[string]*androidpublisher.Money{}
+	}
+	c.prices[key] = prices
+	return prices, nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func (c *GooglePlayClient) GetReview(
+	ctx context.Context,
+	packageName string,
+	reviewID string,
+) (*androidpublisher.Review, error) {
+	return c.service.Reviews.Get(packageName, reviewID).Context(ctx).Do()
+}
+
+// ListReviews returns the reviews of an app, most recent first. Google Play
+// only lists reviews which were written or changed in the last week. Reviews
+// are translated into translationLanguage, unless it is "".
+func (c *GooglePlayClient) ListReviews(
+	ctx context.Context,
+	packageName string,
+	translationLanguage string,
+) ([]*androidpublisher.Review, error) {
+	reviews := []*androidpublisher.Review{}
+	token := ""
+	for {
+		call := c.service.Reviews.List(packageName).MaxResults(100).Context(ctx)
+		if translationLanguage != "" {
+			call = call.TranslationLanguage(translationLanguage)
+		}
+		if token != "" {
+			call = call.Token(token)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, resp.Reviews...)
+
+		if resp.TokenPagination == nil || resp.TokenPagination.NextPageToken == "" {
+			return reviews, nil
+		}
+		token = resp.TokenPagination.NextPageToken
+	}
+}
+
+// ReplyToReview replies to a review, replacing any existing reply.
+func (c *GooglePlayClient) ReplyToReview(
+	ctx context.Context,
+	packageName string,
+	reviewID string,
+	text string,
+) (*androidpublisher.ReviewReplyResult, error) {
+	resp, err := c.service.Reviews.Reply(packageName, reviewID, &androidpublisher.ReviewsReplyRequest{
+		ReplyText: text,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Result, nil
+}
+
+// userComment returns the review written by the user, or nil if there is none.
+func userComment(review *androidpublisher.Review) *androidpublisher.UserComment {
+	for _, comment := range review.Comments {
+		if comment.UserComment != nil {
+			return comment.UserComment
+		}
+	}
+	return nil
+}
+
+// developerReply returns the developer's reply to a review, or nil if there
+// is no reply.
+func developerReply(review *androidpublisher.Review) *androidpublisher.DeveloperComment {
+	for _, comment := range review.Comments {
+		if comment.DeveloperComment != nil {
+			return comment.DeveloperComment
+		}
+	}
+	return nil
+}
+
+// formatTimestamp formats a timestamp returned by the API as RFC 3339, or
+// returns "" if there is no timestamp.
+func formatTimestamp(timestamp *androidpublisher.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return time.Unix(timestamp.Seconds, timestamp.Nanos).UTC().Format(time.RFC3339)
+}
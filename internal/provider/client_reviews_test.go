@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestReplyToReview(t *testing.T) {
+	var request androidpublisher.ReviewsReplyRequest
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &request))
+		_, _ = w.Write([]byte(`{"result": {"replyText": "Thanks!", "lastEdited": {"seconds": "1764288000"}}}`))
+	})
+
+	result, err := client.ReplyToReview(context.Background(), "com.example.app", "review-1", "Thanks!")
+	assert.NoError(t, err)
+	assert.Equal(t, "Thanks!", request.ReplyText)
+	assert.Equal(t, "2025-11-28T00:00:00Z", formatTimestamp(result.LastEdited))
+	assert.Equal(t, []string{"POST /reviews/review-1:reply"}, *requests)
+}
+
+func TestDeveloperReply(t *testing.T) {
+	client, _ := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"reviewId": "review-1", "comments": [
+			{"userComment": {"text": "Great app", "starRating": 5}},
+			{"developerComment": {"text": "Thank you!", "lastModified": {"seconds": "1764288000"}}}
+		]}`))
+	})
+
+	review, err := client.GetReview(context.Background(), "com.example.app", "review-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Thank you!", developerReply(review).Text)
+
+	review.Comments = review.Comments[:1]
+	assert.Nil(t, developerReply(review))
+	assert.Equal(t, "", formatTimestamp(nil))
+}
+
+func TestListReviewsPages(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "en", r.URL.Query().Get("translationLanguage"))
+		if r.URL.Query().Get("token") == "" {
+			_, _ = w.Write([]byte(`{"reviews": [{"reviewId": "review-1"}], "tokenPagination": {"nextPageToken": "2"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"reviews": [{"reviewId": "review-2"}]}`))
+	})
+
+	reviews, err := client.ListReviews(context.Background(), "com.example.app", "en")
+	assert.NoError(t, err)
+	assert.Len(t, reviews, 2)
+	assert.Equal(t, "review-2", reviews[1].ReviewId)
+	assert.Equal(t, []string{
+		"GET /reviews",
+		"GET /reviews",
+	}, *requests)
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// defaultRegionsVersion is the version of the regions which Google Play
+// prices subscriptions in, when a resource does not set one.
+const defaultRegionsVersion = "2022/02"
+
+func (c *GooglePlayClient) GetSubscription(
+	ctx context.Context,
+	packageName string,
+	productID string,
+) (*androidpublisher.Subscription, error) {
+	return c.service.Monetization.Subscriptions.Get(packageName, productID).Context(ctx).Do()
+}
+
+func (c *GooglePlayClient) CreateSubscription(
+	ctx context.Context,
+	subscription *androidpublisher.Subscription,
+	regionsVersion string,
+) (*androidpublisher.Subscription, error) {
+	return c.service.Monetization.Subscriptions.Create(subscription.PackageName, subscription).
+		ProductId(subscription.ProductId).
+		RegionsVersionVersion(regionsVersion).
+		Context(ctx).
+		Do()
+}
+
+// UpdateSubscription saves the fields of subscription listed in updateMask.
+func (c *GooglePlayClient) UpdateSubscription(
+	ctx context.Context,
+	subscription *androidpublisher.Subscription,
+	updateMask []string,
+	regionsVersion string,
+) (*androidpublisher.Subscription, error) {
+	return c.service.Monetization.Subscriptions.Patch(subscription.PackageName, subscription.ProductId, subscription).
+		UpdateMask(strings.Join(updateMask, ",")).
+		RegionsVersionVersion(regionsVersion).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) DeleteSubscription(
+	ctx context.Context,
+	packageName string,
+	productID string,
+) error {
+	return c.service.Monetization.Subscriptions.Delete(packageName, productID).Context(ctx).Do()
+}
+
+// GetBasePlan returns the base plan of a subscription, or a not found error
+// if the subscription has no base plan with that ID.
+func (c *GooglePlayClient) GetBasePlan(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+) (*androidpublisher.BasePlan, error) {
+	subscription, err := c.GetSubscription(ctx, packageName, productID)
+	if err != nil {
+		return nil, err
+	}
+	basePlan := findBasePlan(subscription, basePlanID)
+	if basePlan == nil {
+		return nil, &googleapi.Error{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("subscription %s has no base plan %s", productID, basePlanID),
+		}
+	}
+	return basePlan, nil
+}
+
+// PutBasePlan adds basePlan to the subscription, or replaces the base plan
+// with the same ID. New base plans are saved as drafts.
+func (c *GooglePlayClient) PutBasePlan(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlan *androidpublisher.BasePlan,
+	regionsVersion string,
+) (*androidpublisher.BasePlan, error) {
+	unlock := c.subscriptions.lock(packageName + "/" + productID)
+	defer unlock()
+
+	subscription, err := c.GetSubscription(ctx, packageName, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(subscription.BasePlans, func(existing *androidpublisher.BasePlan) bool {
+		return existing.BasePlanId == basePlan.BasePlanId
+	})
+	if index < 0 {
+		subscription.BasePlans = append(subscription.BasePlans, basePlan)
+	} else {
+		subscription.BasePlans[index] = basePlan
+	}
+
+	subscription, err = c.service.Monetization.Subscriptions.Patch(packageName, productID, subscription).
+		UpdateMask("basePlans").
+		RegionsVersionVersion(regionsVersion).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	return findBasePlan(subscription, basePlan.BasePlanId), nil
+}
+
+func (c *GooglePlayClient) ActivateBasePlan(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+) (*androidpublisher.Subscription, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.
+		Activate(packageName, productID, basePlanID, &androidpublisher.ActivateBasePlanRequest{}).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) DeactivateBasePlan(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+) (*androidpublisher.Subscription, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.
+		Deactivate(packageName, productID, basePlanID, &androidpublisher.DeactivateBasePlanRequest{}).
+		Context(ctx).
+		Do()
+}
+
+// DeleteBasePlan deletes a base plan. Only draft base plans can be deleted.
+func (c *GooglePlayClient) DeleteBasePlan(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+) error {
+	return c.service.Monetization.Subscriptions.BasePlans.Delete(packageName, productID, basePlanID).Context(ctx).Do()
+}
+
+func findBasePlan(subscription *androidpublisher.Subscription, basePlanID string) *androidpublisher.BasePlan {
+	for _, basePlan := range subscription.BasePlans {
+		if basePlan.BasePlanId == basePlanID {
+			return basePlan
+		}
+	}
+	return nil
+}
+
+// subscriptionOfferUpdateMask lists the fields of an offer that can be changed.
+const subscriptionOfferUpdateMask = "phases,regionalConfigs,otherRegionsConfig,targeting,offerTags"
+
+func (c *GooglePlayClient) GetSubscriptionOffer(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+	offerID string,
+) (*androidpublisher.SubscriptionOffer, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.Offers.Get(packageName, productID, basePlanID, offerID).Context(ctx).Do()
+}
+
+func (c *GooglePlayClient) CreateSubscriptionOffer(
+	ctx context.Context,
+	offer *androidpublisher.SubscriptionOffer,
+	regionsVersion string,
+) (*androidpublisher.SubscriptionOffer, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.Offers.Create(offer.PackageName, offer.ProductId, offer.BasePlanId, offer).
+		OfferId(offer.OfferId).
+		RegionsVersionVersion(regionsVersion).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) UpdateSubscriptionOffer(
+	ctx context.Context,
+	offer *androidpublisher.SubscriptionOffer,
+	regionsVersion string,
+) (*androidpublisher.SubscriptionOffer, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.Offers.Patch(offer.PackageName, offer.ProductId, offer.BasePlanId, offer.OfferId, offer).
+		UpdateMask(subscriptionOfferUpdateMask).
+		RegionsVersionVersion(regionsVersion).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) ActivateSubscriptionOffer(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+	offerID string,
+) (*androidpublisher.SubscriptionOffer, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.Offers.
+		Activate(packageName, productID, basePlanID, offerID, &androidpublisher.ActivateSubscriptionOfferRequest{}).
+		Context(ctx).
+		Do()
+}
+
+func (c *GooglePlayClient) DeactivateSubscriptionOffer(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+	offerID string,
+) (*androidpublisher.SubscriptionOffer, error) {
+	return c.service.Monetization.Subscriptions.BasePlans.Offers.
+		Deactivate(packageName, productID, basePlanID, offerID, &androidpublisher.DeactivateSubscriptionOfferRequest{}).
+		Context(ctx).
+		Do()
+}
+
+// DeleteSubscriptionOffer deletes an offer. Only draft offers can be deleted.
+func (c *GooglePlayClient) DeleteSubscriptionOffer(
+	ctx context.Context,
+	packageName string,
+	productID string,
+	basePlanID string,
+	offerID string,
+) error {
+	return c.service.Monetization.Subscriptions.BasePlans.Offers.Delete(packageName, productID, basePlanID, offerID).Context(ctx).Do()
+}
+
+// ListSubscriptions returns every subscription of an app, including archived
+// subscriptions if showArchived is set.
+func (c *GooglePlayClient) ListSubscriptions(
+	ctx context.Context,
+	packageName string,
+	showArchived bool,
+) ([]*androidpublisher.Subscription, error) {
+	subscriptions := []*androidpublisher.Subscription{}
+	err := c.service.Monetization.Subscriptions.List(packageName).
+		ShowArchived(showArchived).
+		PageSize(1000).
+		Pages(ctx, func(response *androidpublisher.ListSubscriptionsResponse) error {
+			subscriptions = append(subscriptions, response.Subscriptions...)
+			return nil
+		})
+	return subscriptions, err
+}
+
+// ListSubscriptionOffers returns the offers of every base plan of every
+// subscription of an app.
+func (c *GooglePlayClient) ListSubscriptionOffers(
+	ctx context.Context,
+	packageName string,
+) ([]*androidpublisher.SubscriptionOffer, error) {
+	offers := []*androidpublisher.SubscriptionOffer{}
+	err := c.service.Monetization.Subscriptions.BasePlans.Offers.List(packageName, "-", "-").
+		PageSize(1000).
+		Pages(ctx, func(response *androidpublisher.ListSubscriptionOffersResponse) error {
+			offers = append(offers, response.SubscriptionOffers...)
+			return nil
+		})
+	return offers, err
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestPutBasePlanKeepsOtherBasePlans(t *testing.T) {
+	var patched androidpublisher.Subscription
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			assert.Equal(t, "basePlans", r.URL.Query().Get("updateMask"))
+			assert.Equal(t, "2022/02", r.URL.Query().Get("regionsVersion.version"))
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &patched))
+			_, _ = w.Write(body)
+			return
+		}
+		_, _ = w.Write([]byte(`{"productId": "premium", "basePlans": [
+			{"basePlanId": "monthly", "state": "ACTIVE"},
+			{"basePlanId": "yearly", "state": "DRAFT"}
+		]}`))
+	})
+
+	basePlan, err := client.PutBasePlan(context.Background(), "com.example.app", "premium", &androidpublisher.BasePlan{
+		BasePlanId:          "yearly",
+		PrepaidBasePlanType: &androidpublisher.PrepaidBasePlanType{BillingPeriodDuration: "P1Y"},
+	}, defaultRegionsVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, "P1Y", basePlan.PrepaidBasePlanType.BillingPeriodDuration)
+	assert.Len(t, patched.BasePlans, 2)
+	assert.Equal(t, "monthly", patched.BasePlans[0].BasePlanId)
+	assert.Equal(t, []string{
+		"GET /subscriptions/premium",
+		"PATCH /subscriptions/premium",
+	}, *requests)
+}
+
+func TestGetBasePlanNotFound(t *testing.T) {
+	client, _ := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"productId": "premium", "basePlans": [{"basePlanId": "monthly"}]}`))
+	})
+
+	_, err := client.GetBasePlan(context.Background(), "com.example.app", "premium", "yearly")
+	assert.True(t, isNotFound(err))
+}
+
+func TestListSubscriptionsPages(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("showArchived"))
+		if r.URL.Query().Get("pageToken") == "" {
+			_, _ = w.Write([]byte(`{"subscriptions": [{"productId": "basic"}], "nextPageToken": "2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"subscriptions": [{"productId": "premium", "archived": true}]}`))
+	})
+
+	subscriptions, err := client.ListSubscriptions(context.Background(), "com.example.app", true)
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 2)
+	assert.True(t, subscriptions[1].Archived)
+	assert.Equal(t, []string{
+		"GET /subscriptions",
+		"GET /subscriptions",
+	}, *requests)
+}
+
+func TestListSubscriptionOffersAcrossApp(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subscriptionOffers": [{"productId": "premium", "basePlanId": "monthly", "offerId": "trial"}]}`))
+	})
+
+	offers, err := client.ListSubscriptionOffers(context.Background(), "com.example.app")
+	assert.NoError(t, err)
+	assert.Equal(t, "trial", offers[0].OfferId)
+	assert.Equal(t, []string{"GET /subscriptions/-/basePlans/-/offers"}, *requests)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func (c *GooglePlayClient) GetTesters(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+) ([]string, error) {
+	testers, err := c.service.Edits.Testers.Get(packageName, editID, track).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return testers.GoogleGroups, nil
+}
+
+// SetTesters replaces the Google Groups that can test the track.
+func (c *GooglePlayClient) SetTesters(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+	googleGroups []string,
+) ([]string, error) {
+	testers := &androidpublisher.Testers{
+		GoogleGroups: googleGroups,
+		// An empty list must still be sent to remove every group
+		ForceSendFields: []string{"GoogleGroups"},
+	}
+	testers, err := c.service.Edits.Testers.Update(packageName, editID, track, testers).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return testers.GoogleGroups, nil
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func (c *GooglePlayClient) GetTrack(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+) (*androidpublisher.Track, error) {
+	return c.service.Edits.Tracks.Get(packageName, editID, track).Context(ctx).Do()
+}
+
+func (c *GooglePlayClient) UpdateTrack(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track *androidpublisher.Track,
+) (*androidpublisher.Track, error) {
+	return c.service.Edits.Tracks.Update(packageName, editID, track.Track, track).Context(ctx).Do()
+}
+
+// UpdateRelease adds release to the track, replacing any earlier version of it.
+func (c *GooglePlayClient) UpdateRelease(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+	release *androidpublisher.TrackRelease,
+) (*androidpublisher.TrackRelease, error) {
+	existing, err := c.GetTrack(ctx, packageName, editID, track)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := c.UpdateTrack(ctx, packageName, editID, &androidpublisher.Track{
+		Track:    track,
+		Releases: mergeTrackReleases(existing.Releases, release),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found := findTrackRelease(updated.Releases, release.VersionCodes); found != nil {
+		return found, nil
+	}
+	return release, nil
+}
+
+// RemoveRelease removes the release containing versionCodes from the track.
+func (c *GooglePlayClient) RemoveRelease(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+	versionCodes []int64,
+) error {
+	existing, err := c.GetTrack(ctx, packageName, editID, track)
+	if err != nil {
+		return err
+	}
+
+	releases := slices.DeleteFunc(existing.Releases, func(release *androidpublisher.TrackRelease) bool {
+		return sameVersionCodes(release.VersionCodes, versionCodes)
+	})
+
+	_, err = c.UpdateTrack(ctx, packageName, editID, &androidpublisher.Track{
+		Track:    track,
+		Releases: releases,
+	})
+	return err
+}
+
+// mergeTrackReleases returns the releases a track should have once release is added.
+//
+// A track serves at most one completed release alongside a staged rollout,
+// so completed releases are kept while a new release rolls out, and drafts are
+// kept unless release is itself a draft. Any earlier version of release, which
+// shares its version codes, is replaced.
+func mergeTrackReleases(
+	existing []*androidpublisher.TrackRelease,
+	release *androidpublisher.TrackRelease,
+) []*androidpublisher.TrackRelease {
+	status := ReleaseStatus(release.Status)
+	releases := []*androidpublisher.TrackRelease{}
+
+	for _, other := range existing {
+		if sharesVersionCode(other.VersionCodes, release.VersionCodes) {
+			continue
+		}
+		switch ReleaseStatus(other.Status) {
+		case ReleaseCompleted:
+			if status == ReleaseCompleted {
+				continue
+			}
+		case ReleaseDraft:
+			if status == ReleaseDraft {
+				continue
+			}
+		default:
+			continue
+		}
+		releases = append(releases, other)
+	}
+
+	return append(releases, release)
+}
+
+// findTrackRelease returns the release with exactly the given version codes.
+func findTrackRelease(
+	releases []*androidpublisher.TrackRelease,
+	versionCodes []int64,
+) *androidpublisher.TrackRelease {
+	for _, release := range releases {
+		if sameVersionCodes(release.VersionCodes, versionCodes) {
+			return release
+		}
+	}
+	return nil
+}
+
+func sameVersionCodes(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, code := range a {
+		if !slices.Contains(b, code) {
+			return false
+		}
+	}
+	return true
+}
+
+func sharesVersionCode(a []int64, b []int64) bool {
+	for _, code := range a {
+		if slices.Contains(b, code) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *GooglePlayClient) ListTracks(
+	ctx context.Context,
+	packageName string,
+	editID string,
+) ([]*androidpublisher.Track, error) {
+	resp, err := c.service.Edits.Tracks.List(packageName, editID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tracks, nil
+}
+
+// CreateClosedTrack creates a custom closed testing track.
+func (c *GooglePlayClient) CreateClosedTrack(
+	ctx context.Context,
+	packageName string,
+	editID string,
+	track string,
+	formFactor string,
+) (*androidpublisher.Track, error) {
+	config := &androidpublisher.TrackConfig{
+		Track:      track,
+		FormFactor: formFactor,
+		Type:       "CLOSED_TESTING",
+	}
+	return c.service.Edits.Tracks.Create(packageName, editID, config).Context(ctx).Do()
+}
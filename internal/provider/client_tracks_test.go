@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestMergeTrackReleasesKeepsCompletedDuringRollout(t *testing.T) {
+	completed := &androidpublisher.TrackRelease{Status: "completed", VersionCodes: []int64{1}}
+	rollout := &androidpublisher.TrackRelease{Status: "inProgress", UserFraction: 0.1, VersionCodes: []int64{2}}
+
+	assert.Equal(
+		t,
+		[]*androidpublisher.TrackRelease{completed, rollout},
+		mergeTrackReleases([]*androidpublisher.TrackRelease{completed}, rollout),
+	)
+}
+
+func TestMergeTrackReleasesReplacesEarlierVersion(t *testing.T) {
+	completed := &androidpublisher.TrackRelease{Status: "completed", VersionCodes: []int64{1}}
+	rollout := &androidpublisher.TrackRelease{Status: "inProgress", UserFraction: 0.1, VersionCodes: []int64{2}}
+	increased := &androidpublisher.TrackRelease{Status: "inProgress", UserFraction: 0.5, VersionCodes: []int64{2}}
+
+	assert.Equal(
+		t,
+		[]*androidpublisher.TrackRelease{completed, increased},
+		mergeTrackReleases([]*androidpublisher.TrackRelease{completed, rollout}, increased),
+	)
+}
+
+func TestMergeTrackReleasesCompletedSupersedesAll(t *testing.T) {
+	completed := &androidpublisher.TrackRelease{Status: "completed", VersionCodes: []int64{1}}
+	rollout := &androidpublisher.TrackRelease{Status: "inProgress", UserFraction: 0.1, VersionCodes: []int64{2}}
+	release := &androidpublisher.TrackRelease{Status: "completed", VersionCodes: []int64{3}}
+
+	assert.Equal(
+		t,
+		[]*androidpublisher.TrackRelease{release},
+		mergeTrackReleases([]*androidpublisher.TrackRelease{completed, rollout}, release),
+	)
+}
+
+func TestMergeTrackReleasesKeepsDrafts(t *testing.T) {
+	draft := &androidpublisher.TrackRelease{Status: "draft", VersionCodes: []int64{5}}
+	release := &androidpublisher.TrackRelease{Status: "halted", UserFraction: 0.1, VersionCodes: []int64{3}}
+
+	assert.Equal(
+		t,
+		[]*androidpublisher.TrackRelease{draft, release},
+		mergeTrackReleases([]*androidpublisher.TrackRelease{draft}, release),
+	)
+}
+
+func TestMergeTrackReleasesReplacesDraft(t *testing.T) {
+	draft := &androidpublisher.TrackRelease{Status: "draft", VersionCodes: []int64{5}}
+	release := &androidpublisher.TrackRelease{Status: "draft", VersionCodes: []int64{6}}
+
+	assert.Equal(
+		t,
+		[]*androidpublisher.TrackRelease{release},
+		mergeTrackReleases([]*androidpublisher.TrackRelease{draft}, release),
+	)
+}
+
+func TestFindTrackRelease(t *testing.T) {
+	completed := &androidpublisher.TrackRelease{Status: "completed", VersionCodes: []int64{1}}
+	rollout := &androidpublisher.TrackRelease{Status: "inProgress", VersionCodes: []int64{3, 2}}
+	releases := []*androidpublisher.TrackRelease{completed, rollout}
+
+	assert.Equal(t, rollout, findTrackRelease(releases, []int64{2, 3}))
+	assert.Equal(t, completed, findTrackRelease(releases, []int64{1}))
+	assert.Nil(t, findTrackRelease(releases, []int64{2}))
+}
+
+func TestTrackReleaseIDIncludesVersionCodes(t *testing.T) {
+	assert.Equal(t, "com.example.app/beta/3,12", trackReleaseID("com.example.app", "beta", []int64{12, 3}))
+	assert.NotEqual(t,
+		trackReleaseID("com.example.app", "production", []int64{1}),
+		trackReleaseID("com.example.app", "production", []int64{2}),
+	)
+}
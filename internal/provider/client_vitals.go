@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	playdeveloperreporting "google.golang.org/api/playdeveloperreporting/v1beta1"
+)
+
+// CrashRate summarises the Android vitals crash metrics of an app over a
+// number of days, weighted by the number of users on each day.
+type CrashRate struct {
+	CrashRate              float64
+	UserPerceivedCrashRate float64
+
+	// StartDate and EndDate are the first and last days of data, inclusive.
+	StartDate string
+	EndDate   string
+}
+
+// QueryCrashRate returns the crash rate over the most recent days of data
+// which Android vitals has available. versionCode is 0 to include every version.
+func (c *GooglePlayClient) QueryCrashRate(
+	ctx context.Context,
+	packageName string,
+	versionCode int64,
+	days int,
+) (*CrashRate, error) {
+	name := fmt.Sprintf("apps/%s/crashRateMetricSet", packageName)
+
+	metricSet, err := c.reporting.Vitals.Crashrate.Get(name).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var end *playdeveloperreporting.GoogleTypeDateTime
+	if metricSet.FreshnessInfo != nil {
+		for _, freshness := range metricSet.FreshnessInfo.Freshnesses {
+			if freshness.AggregationPeriod == "DAILY" {
+				end = freshness.LatestEndTime
+			}
+		}
+	}
+	if end == nil {
+		return nil, fmt.Errorf("no daily crash rate data is available for %s", packageName)
+	}
+
+	// Daily data is aligned to whole days, so count back from the latest day
+	endDate := time.Date(int(end.Year), time.Month(end.Month), int(end.Day), 0, 0, 0, 0, time.UTC)
+	startDate := endDate.AddDate(0, 0, -days)
+	start := &playdeveloperreporting.GoogleTypeDateTime{
+		Year:     int64(startDate.Year()),
+		Month:    int64(startDate.Month()),
+		Day:      int64(startDate.Day()),
+		TimeZone: end.TimeZone,
+	}
+
+	request := &playdeveloperreporting.GooglePlayDeveloperReportingV1beta1QueryCrashRateMetricSetRequest{
+		Metrics: []string{"crashRate", "userPerceivedCrashRate", "distinctUsers"},
+		TimelineSpec: &playdeveloperreporting.GooglePlayDeveloperReportingV1beta1TimelineSpec{
+			AggregationPeriod: "DAILY",
+			StartTime:         start,
+			EndTime:           end,
+		},
+	}
+	if versionCode != 0 {
+		request.Dimensions = []string{"versionCode"}
+		request.Filter = fmt.Sprintf("versionCode = %d", versionCode)
+	}
+
+	rows := []*playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricsRow{}
+	err = c.reporting.Vitals.Crashrate.Query(name, request).Pages(
+		ctx,
+		func(resp *playdeveloperreporting.GooglePlayDeveloperReportingV1beta1QueryCrashRateMetricSetResponse) error {
+			rows = append(rows, resp.Rows...)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	crashRate, err := weightedCrashRate(rows)
+	if err != nil {
+		return nil, err
+	}
+	crashRate.StartDate = startDate.Format(time.DateOnly)
+	crashRate.EndDate = endDate.AddDate(0, 0, -1).Format(time.DateOnly)
+	return crashRate, nil
+}
+
+// weightedCrashRate combines daily rows into a single crash rate, weighting
+// each day by its number of distinct users.
+func weightedCrashRate(rows []*playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricsRow) (*CrashRate, error) {
+	var crashes, userPerceivedCrashes, users float64
+
+	for _, row := range rows {
+		metrics := map[string]float64{}
+		for _, metric := range row.Metrics {
+			if metric.DecimalValue == nil || metric.DecimalValue.Value == "" {
+				continue
+			}
+			value, err := strconv.ParseFloat(metric.DecimalValue.Value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse %s: %w", metric.Metric, err)
+			}
+			metrics[metric.Metric] = value
+		}
+
+		crashes += metrics["crashRate"] * metrics["distinctUsers"]
+		userPerceivedCrashes += metrics["userPerceivedCrashRate"] * metrics["distinctUsers"]
+		users += metrics["distinctUsers"]
+	}
+
+	if users == 0 {
+		return &CrashRate{}, nil
+	}
+	return &CrashRate{
+		CrashRate:              crashes / users,
+		UserPerceivedCrashRate: userPerceivedCrashes / users,
+	}, nil
+}
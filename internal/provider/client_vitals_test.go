@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	playdeveloperreporting "google.golang.org/api/playdeveloperreporting/v1beta1"
+)
+
+func crashRateRow(crashRate string, userPerceivedCrashRate string, distinctUsers string) *playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricsRow {
+	metric := func(name string, value string) *playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricValue {
+		return &playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricValue{
+			Metric:       name,
+			DecimalValue: &playdeveloperreporting.GoogleTypeDecimal{Value: value},
+		}
+	}
+	return &playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricsRow{
+		Metrics: []*playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricValue{
+			metric("crashRate", crashRate),
+			metric("userPerceivedCrashRate", userPerceivedCrashRate),
+			metric("distinctUsers", distinctUsers),
+		},
+	}
+}
+
+func TestWeightedCrashRate(t *testing.T) {
+	crashRate, err := weightedCrashRate([]*playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricsRow{
+		crashRateRow("0.01", "0.005", "1000"),
+		crashRateRow("0.04", "0.02", "3000"),
+	})
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.0325, crashRate.CrashRate, 1e-9)
+	assert.InDelta(t, 0.01625, crashRate.UserPerceivedCrashRate, 1e-9)
+}
+
+func TestWeightedCrashRateWithoutUsers(t *testing.T) {
+	crashRate, err := weightedCrashRate(nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, crashRate.CrashRate)
+}
+
+func TestWeightedCrashRateInvalidDecimal(t *testing.T) {
+	_, err := weightedCrashRate([]*playdeveloperreporting.GooglePlayDeveloperReportingV1beta1MetricsRow{
+		crashRateRow("not a number", "0", "10"),
+	})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ function.Function = &ConvertRegionPricesFunction{}
+
+func NewConvertRegionPricesFunction() function.Function {
+	return &ConvertRegionPricesFunction{}
+}
+
+type ConvertRegionPricesFunction struct{}
+
+func (f *ConvertRegionPricesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_region_prices"
+}
+
+func (f *ConvertRegionPricesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a price into the local currency of every region",
+		MarkdownDescription: `Converts a price into the local currency of every region where Google Play sells products, using Google Play's
+		exchange rates and pricing conventions. Returns a map of region code to ` + "`currency`" + `, ` + "`units`" + ` and ` + "`nanos`" + `,
+		which matches a subscription base plan ` + "`price`" + `, and ` + "`price_micros`" + `, which matches an in-app product price.
+
+		Provider functions cannot read the provider configuration, so this function authenticates with the
+		` + "`GOOGLE_APPLICATION_CREDENTIALS`" + ` environment variable. Results are cached for the rest of the plan or apply`,
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "package_name",
+				MarkdownDescription: "The package name of an app in the developer account, for example `com.example.app`",
+			},
+			function.NumberParameter{
+				Name:                "units",
+				MarkdownDescription: "The amount to convert, for example `9.99`",
+			},
+			function.StringParameter{
+				Name:                "currency",
+				MarkdownDescription: "The ISO 4217 currency code of the amount, for example `USD`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: moneyObjectType,
+		},
+	}
+}
+
+func (f *ConvertRegionPricesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var packageName, currency string
+	var amount *big.Float
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &packageName, &amount, &currency))
+	if resp.Error != nil {
+		return
+	}
+
+	if amount.Sign() <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, "units must be greater than 0")
+		return
+	}
+
+	units, nanos := splitAmount(amount)
+	converted, err := regionPrices.convert(ctx, packageName, &androidpublisher.Money{
+		Units:        units,
+		Nanos:        nanos,
+		CurrencyCode: strings.ToUpper(currency),
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to convert region prices: " + err.Error())
+		return
+	}
+
+	prices := map[string]moneyObjectModel{}
+	for region, price := range converted {
+		prices[region] = moneyObjectValue(price)
+	}
+
+	result, diags := types.MapValueFrom(ctx, moneyObjectType, prices)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
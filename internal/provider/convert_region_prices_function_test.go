@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func regionPricesHandler(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte(`{"convertedRegionPrices": {
+		"GB": {"regionCode": "GB", "price": {"currencyCode": "GBP", "units": "7", "nanos": 990000000}},
+		"JP": {"regionCode": "JP", "price": {"currencyCode": "JPY", "units": "1500"}}
+	}}`))
+}
+
+func TestConvertRegionPricesFunction(t *testing.T) {
+	client, requests := testPublisherClient(t, regionPricesHandler)
+	cache := regionPrices
+	regionPrices = &regionPriceCache{client: client}
+	t.Cleanup(func() { regionPrices = cache })
+
+	run := func() function.RunResponse {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{
+				types.StringValue("com.example.app"),
+				types.NumberValue(big.NewFloat(9.99)),
+				types.StringValue("usd"),
+			}),
+		}
+		resp := function.RunResponse{Result: function.NewResultData(types.MapUnknown(moneyObjectType))}
+		(&ConvertRegionPricesFunction{}).Run(context.Background(), req, &resp)
+		return resp
+	}
+
+	resp := run()
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.MapValueMust(moneyObjectType, map[string]attr.Value{
+		"GB": types.ObjectValueMust(moneyObjectType.AttrTypes, map[string]attr.Value{
+			"currency":     types.StringValue("GBP"),
+			"units":        types.Int64Value(7),
+			"nanos":        types.Int64Value(990000000),
+			"price_micros": types.Int64Value(7990000),
+		}),
+		"JP": types.ObjectValueMust(moneyObjectType.AttrTypes, map[string]attr.Value{
+			"currency":     types.StringValue("JPY"),
+			"units":        types.Int64Value(1500),
+			"nanos":        types.Int64Value(0),
+			"price_micros": types.Int64Value(1500000000),
+		}),
+	}), resp.Result.Value())
+
+	// The same price is only converted once per apply
+	assert.Nil(t, run().Error)
+	assert.Equal(t, []string{"POST /pricing:convertRegionPrices"}, *requests)
+}
+
+func TestConvertRegionPricesFunctionRejectsZero(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("com.example.app"),
+			types.NumberValue(big.NewFloat(0)),
+			types.StringValue("USD"),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.MapUnknown(moneyObjectType))}
+	(&ConvertRegionPricesFunction{}).Run(context.Background(), req, &resp)
+	assert.Equal(t, function.NewArgumentFuncError(1, "units must be greater than 0"), resp.Error)
+}
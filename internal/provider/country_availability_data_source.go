@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ datasource.DataSource = &CountryAvailabilityDataSource{}
+
+func NewCountryAvailabilityDataSource() datasource.DataSource {
+	return &CountryAvailabilityDataSource{}
+}
+
+type CountryAvailabilityDataSource struct {
+	client *GooglePlayClient
+}
+
+type countryAvailabilityDataSourceModel struct {
+	PackageName        types.String `tfsdk:"package_name"`
+	Track              types.String `tfsdk:"track"`
+	ExpectedCountries  types.Set    `tfsdk:"expected_countries"`
+	Countries          types.Set    `tfsdk:"countries"`
+	RestOfWorld        types.Bool   `tfsdk:"rest_of_world"`
+	SyncWithProduction types.Bool   `tfsdk:"sync_with_production"`
+}
+
+func (d *CountryAvailabilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_country_availability"
+}
+
+func (d *CountryAvailabilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Fetch the countries that a track is available in.
+		Set ` + "`expected_countries`" + ` to fail when the track's availability does not match`,
+
+		Attributes: map[string]schema.Attribute{
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+			},
+			"track": schema.StringAttribute{
+				MarkdownDescription: "The track to read, for example `production`",
+				Required:            true,
+			},
+			"expected_countries": schema.SetAttribute{
+				MarkdownDescription: "The ISO 3166 country codes the track should be available in. An error is raised if `countries` is different",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"countries": schema.SetAttribute{
+				MarkdownDescription: "The ISO 3166 country codes the track is available in",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"rest_of_world": schema.BoolAttribute{
+				MarkdownDescription: "Whether the track is available in countries that Google Play adds in the future",
+				Computed:            true,
+			},
+			"sync_with_production": schema.BoolAttribute{
+				MarkdownDescription: "Whether the track's availability follows the production track",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CountryAvailabilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CountryAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data countryAvailabilityDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var availability *androidpublisher.TrackCountryAvailability
+	err := d.client.ReadEdit(ctx, packageName, func(editID string) error {
+		var err error
+		availability, err = d.client.GetCountryAvailability(ctx, packageName, editID, data.Track.ValueString())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch country availability",
+			err.Error(),
+		)
+		return
+	}
+
+	countries := countryCodes(availability.Countries)
+
+	if !data.ExpectedCountries.IsNull() {
+		expected := []string{}
+		resp.Diagnostics.Append(data.ExpectedCountries.ElementsAs(ctx, &expected, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		missing, unexpected := diffCountries(expected, countries)
+		if len(missing) > 0 || len(unexpected) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_countries"),
+				"Unexpected country availability",
+				fmt.Sprintf(
+					"The %s track does not match the expected countries.\n\nNot available in: %s\nUnexpectedly available in: %s",
+					data.Track.ValueString(),
+					countryList(missing),
+					countryList(unexpected),
+				),
+			)
+			return
+		}
+	}
+
+	data.Countries = stringSetValue(ctx, countries, &resp.Diagnostics)
+	data.RestOfWorld = types.BoolValue(availability.RestOfWorld)
+	data.SyncWithProduction = types.BoolValue(availability.SyncWithProduction)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func countryList(countries []string) string {
+	if len(countries) == 0 {
+		return "none"
+	}
+	return strings.Join(countries, ", ")
+}
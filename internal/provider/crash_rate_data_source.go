@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CrashRateDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CrashRateDataSource{}
+
+func NewCrashRateDataSource() datasource.DataSource {
+	return &CrashRateDataSource{}
+}
+
+type CrashRateDataSource struct {
+	client *GooglePlayClient
+}
+
+type crashRateDataSourceModel struct {
+	PackageName            types.String  `tfsdk:"package_name"`
+	VersionCode            types.Int64   `tfsdk:"version_code"`
+	Days                   types.Int64   `tfsdk:"days"`
+	CrashRate              types.Float64 `tfsdk:"crash_rate"`
+	UserPerceivedCrashRate types.Float64 `tfsdk:"user_perceived_crash_rate"`
+	StartDate              types.String  `tfsdk:"start_date"`
+	EndDate                types.String  `tfsdk:"end_date"`
+}
+
+func (d *CrashRateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crash_rate"
+}
+
+func (d *CrashRateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Fetch the Android vitals crash rate of an app:
+		https://developers.google.com/play/developer/reporting/reference/rest/v1beta1/vitals.crashrate`,
+
+		Attributes: map[string]schema.Attribute{
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+			},
+			"version_code": schema.Int64Attribute{
+				MarkdownDescription: "Only include crashes from this version of the app. Defaults to every version",
+				Optional:            true,
+			},
+			"days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days of data to include, counting back from the most recent day available. Defaults to 1",
+				Optional:            true,
+			},
+			"crash_rate": schema.Float64Attribute{
+				MarkdownDescription: "The fraction of users who experienced at least one crash, weighted by the number of users on each day",
+				Computed:            true,
+			},
+			"user_perceived_crash_rate": schema.Float64Attribute{
+				MarkdownDescription: "The fraction of users who experienced at least one crash while actively using the app, weighted by the number of users on each day",
+				Computed:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The first day included in the crash rate, in the format `YYYY-MM-DD`",
+				Computed:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The last day included in the crash rate, in the format `YYYY-MM-DD`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CrashRateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CrashRateDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data crashRateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Days.IsNull() && !data.Days.IsUnknown() && data.Days.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("days"),
+			"Invalid number of days",
+			"days must be at least 1.",
+		)
+	}
+}
+
+func (d *CrashRateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data crashRateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	days := int64(1)
+	if !data.Days.IsNull() {
+		days = data.Days.ValueInt64()
+	}
+
+	crashRate, err := d.client.QueryCrashRate(
+		ctx,
+		data.PackageName.ValueString(),
+		data.VersionCode.ValueInt64(),
+		int(days),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch crash rate",
+			err.Error(),
+		)
+		return
+	}
+
+	data.CrashRate = types.Float64Value(crashRate.CrashRate)
+	data.UserPerceivedCrashRate = types.Float64Value(crashRate.UserPerceivedCrashRate)
+	data.StartDate = types.StringValue(crashRate.StartDate)
+	data.EndDate = types.StringValue(crashRate.EndDate)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeobfuscationFileResource{}
+var _ resource.ResourceWithValidateConfig = &DeobfuscationFileResource{}
+
+// deobfuscationFileTypes are the kinds of symbol file accepted by Google Play.
+var deobfuscationFileTypes = []string{"proguard", "nativeCode"}
+
+func NewDeobfuscationFileResource() resource.Resource {
+	return &DeobfuscationFileResource{}
+}
+
+type DeobfuscationFileResource struct {
+	client *GooglePlayClient
+}
+
+type deobfuscationFileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PackageName types.String `tfsdk:"package_name"`
+	VersionCode types.Int64  `tfsdk:"version_code"`
+	Type        types.String `tfsdk:"type"`
+	File        types.String `tfsdk:"file"`
+	SHA256      types.String `tfsdk:"sha256"`
+
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+func (r *DeobfuscationFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deobfuscation_file"
+}
+
+func (r *DeobfuscationFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Upload a ProGuard/R8 mapping file or native debug symbols for an APK or bundle,
+		so that crashes and ANRs in Android Vitals can be deobfuscated.
+		The file is uploaded in its own edit, so the APK or bundle must already have been committed.
+		To upload the file in the same edit as an APK or bundle, set mapping_file or native_debug_symbols on the googleplay_apk or googleplay_bundle resource instead.
+		Google Play does not allow deobfuscation files to be read back or deleted, so destroying this resource only removes it from Terraform state`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the deobfuscation file, in the format `package_name/version_code/type`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_code": schema.Int64Attribute{
+				MarkdownDescription: "The version code of the APK or bundle the file belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of deobfuscation file, one of: " + strings.Join(deobfuscationFileTypes, ", "),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path to the file to upload, such as `mapping.txt` or a zip of native debug symbols",
+				Required:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 of the uploaded file. The file is uploaded again whenever the contents of `file` change",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					hashFilePlanModifier(path.Root("file")),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+	}
+}
+
+func (r *DeobfuscationFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeobfuscationFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data deobfuscationFileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.IsUnknown() && !data.Type.IsNull() &&
+		!slices.Contains(deobfuscationFileTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid deobfuscation file type",
+			fmt.Sprintf("'%s' is not a valid deobfuscation file type, expected one of: %s.", data.Type.ValueString(), strings.Join(deobfuscationFileTypes, ", ")),
+		)
+	}
+}
+
+func (r *DeobfuscationFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data deobfuscationFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file := data.File.ValueString()
+	sha256, err := fileSHA256(file)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		_, err := r.client.UploadDeobfuscationFile(
+			ctx, packageName, editID,
+			data.VersionCode.ValueInt64(), data.Type.ValueString(), file,
+		)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to upload deobfuscation file",
+			err.Error(),
+		)
+		return
+	}
+
+	data.setFile(sha256)
+	data.SentForReview = types.BoolValue(sentForReview)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeobfuscationFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data deobfuscationFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no way to read deobfuscation files back, so the uploaded
+	// file is assumed to be unchanged.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeobfuscationFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data deobfuscationFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the path to the file has changed: its contents are the same, so
+	// there is nothing to upload or commit.
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("sent_for_review"), &data.SentForReview)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeobfuscationFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deobfuscation files cannot be deleted once uploaded, so the file is only
+	// removed from Terraform state.
+	tflog.Info(ctx, "deobfuscation files cannot be deleted from Google Play, removing from state only")
+}
+
+// setFile records the upload of the file with the given SHA-256.
+func (data *deobfuscationFileResourceModel) setFile(sha256 string) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%d/%s", data.PackageName.ValueString(), data.VersionCode.ValueInt64(), data.Type.ValueString()))
+	data.SHA256 = types.StringValue(sha256)
+}
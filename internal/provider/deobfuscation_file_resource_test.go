@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeobfuscationFileSetFile(t *testing.T) {
+	data := deobfuscationFileResourceModel{
+		PackageName: types.StringValue("com.example.app"),
+		VersionCode: types.Int64Value(42),
+		Type:        types.StringValue("nativeCode"),
+		File:        types.StringValue("symbols.zip"),
+	}
+	data.setFile("abcd")
+
+	assert.Equal(t, "com.example.app/42/nativeCode", data.ID.ValueString())
+	assert.Equal(t, "abcd", data.SHA256.ValueString())
+	assert.Equal(t, "symbols.zip", data.File.ValueString())
+}
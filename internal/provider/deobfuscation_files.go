@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deobfuscationFilesModel holds the deobfuscation files uploaded with an APK
+// or bundle. It is embedded in the models of both resources.
+type deobfuscationFilesModel struct {
+	MappingFile              types.String `tfsdk:"mapping_file"`
+	MappingFileSHA256        types.String `tfsdk:"mapping_file_sha256"`
+	NativeDebugSymbols       types.String `tfsdk:"native_debug_symbols"`
+	NativeDebugSymbolsSHA256 types.String `tfsdk:"native_debug_symbols_sha256"`
+}
+
+// deobfuscationFileAttributes returns the attributes of deobfuscationFilesModel
+// for an APK or bundle.
+func deobfuscationFileAttributes(binary string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"mapping_file": schema.StringAttribute{
+			MarkdownDescription: "Path to the ProGuard or R8 mapping file of the " + binary + ". It is uploaded in the same edit as the " + binary +
+				", and uploaded again for the same version code whenever its contents change",
+			Optional: true,
+		},
+		"mapping_file_sha256": schema.StringAttribute{
+			MarkdownDescription: "The SHA-256 of the uploaded mapping file",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				hashFilePlanModifier(path.Root("mapping_file")),
+			},
+		},
+		"native_debug_symbols": schema.StringAttribute{
+			MarkdownDescription: "Path to a zip of the native debug symbols of the " + binary + ". It is uploaded in the same edit as the " + binary +
+				", and uploaded again for the same version code whenever its contents change",
+			Optional: true,
+		},
+		"native_debug_symbols_sha256": schema.StringAttribute{
+			MarkdownDescription: "The SHA-256 of the uploaded native debug symbols",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				hashFilePlanModifier(path.Root("native_debug_symbols")),
+			},
+		},
+	}
+}
+
+// hash records the SHA-256 of each configured file, and returns the path of
+// any file which cannot be read.
+func (m *deobfuscationFilesModel) hash() (path.Path, error) {
+	files := []struct {
+		attribute string
+		file      types.String
+		sha256    *types.String
+	}{
+		{"mapping_file", m.MappingFile, &m.MappingFileSHA256},
+		{"native_debug_symbols", m.NativeDebugSymbols, &m.NativeDebugSymbolsSHA256},
+	}
+	for _, file := range files {
+		*file.sha256 = types.StringNull()
+		if file.file.IsNull() {
+			continue
+		}
+		hash, err := fileSHA256(file.file.ValueString())
+		if err != nil {
+			return path.Root(file.attribute), err
+		}
+		*file.sha256 = types.StringValue(hash)
+	}
+	return path.Empty(), nil
+}
+
+// changed returns the paths of the files to upload, keyed by deobfuscation
+// file type: every configured file when prior is nil, and otherwise only those
+// whose contents have changed. Files which are removed are kept by Google Play.
+func (m deobfuscationFilesModel) changed(prior *deobfuscationFilesModel) map[string]string {
+	paths := map[string]string{}
+	if !m.MappingFile.IsNull() && (prior == nil || !m.MappingFileSHA256.Equal(prior.MappingFileSHA256)) {
+		paths["proguard"] = m.MappingFile.ValueString()
+	}
+	if !m.NativeDebugSymbols.IsNull() && (prior == nil || !m.NativeDebugSymbolsSHA256.Equal(prior.NativeDebugSymbolsSHA256)) {
+		paths["nativeCode"] = m.NativeDebugSymbols.ValueString()
+	}
+	return paths
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeobfuscationFilesChanged(t *testing.T) {
+	mapping := filepath.Join(t.TempDir(), "mapping.txt")
+	assert.NoError(t, os.WriteFile(mapping, []byte("com.example.A -> a:"), 0o600))
+
+	files := deobfuscationFilesModel{
+		MappingFile:        types.StringValue(mapping),
+		NativeDebugSymbols: types.StringNull(),
+	}
+	_, err := files.hash()
+	assert.NoError(t, err)
+	assert.Len(t, files.MappingFileSHA256.ValueString(), 64)
+	assert.True(t, files.NativeDebugSymbolsSHA256.IsNull())
+
+	// Every configured file is uploaded with a new APK or bundle
+	assert.Equal(t, map[string]string{"proguard": mapping}, files.changed(nil))
+
+	// Only files whose contents changed are uploaded again
+	prior := files
+	assert.Empty(t, files.changed(&prior))
+	prior.MappingFileSHA256 = types.StringValue("0000")
+	assert.Equal(t, map[string]string{"proguard": mapping}, files.changed(&prior))
+
+	// Removing a file does not upload anything
+	removed := deobfuscationFilesModel{MappingFile: types.StringNull(), NativeDebugSymbols: types.StringNull()}
+	assert.Empty(t, removed.changed(&files))
+}
+
+func TestDeobfuscationFilesHashMissing(t *testing.T) {
+	files := deobfuscationFilesModel{
+		MappingFile:        types.StringNull(),
+		NativeDebugSymbols: types.StringValue(filepath.Join(t.TempDir(), "symbols.zip")),
+	}
+	at, err := files.hash()
+	assert.Error(t, err)
+	assert.Equal(t, path.Root("native_debug_symbols"), at)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func hashFilesPlanModifier(files path.Path) planmodifier.List {
+	return &fileHashModifier{
+		source: files,
+	}
+}
+
+type fileHashModifier struct {
+	source path.Path
+}
+
+func (m *fileHashModifier) Description(ctx context.Context) string {
+	return "Plans the SHA-256 of each local file so that content changes are detected"
+}
+
+func (m *fileHashModifier) MarkdownDescription(ctx context.Context) string {
+	return "Plans the SHA-256 of each local file so that content changes are detected"
+}
+
+func (m *fileHashModifier) PlanModifyList(
+	ctx context.Context,
+	req planmodifier.ListRequest,
+	resp *planmodifier.ListResponse,
+) {
+	// nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var files types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.source, &files)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the files may not be known until apply
+	if files.IsUnknown() {
+		resp.PlanValue = types.ListUnknown(types.StringType)
+		return
+	}
+	for _, file := range files.Elements() {
+		if file.IsUnknown() {
+			resp.PlanValue = types.ListUnknown(types.StringType)
+			return
+		}
+	}
+
+	paths := []string{}
+	resp.Diagnostics.Append(files.ElementsAs(ctx, &paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes := make([]string, len(paths))
+	for i, p := range paths {
+		hash, err := fileSHA256(p)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				m.source.AtListIndex(i),
+				"Unable to read file",
+				err.Error(),
+			)
+			return
+		}
+		hashes[i] = hash
+	}
+
+	planValue, diag := types.ListValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diag...)
+	resp.PlanValue = planValue
+}
+
+func hashFilePlanModifier(file path.Path) planmodifier.String {
+	return &fileHashModifier{
+		source: file,
+	}
+}
+
+func (m *fileHashModifier) PlanModifyString(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	// nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var file types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.source, &file)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// optional files have no hash when they are not set
+	if file.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// the file may not be known until apply
+	if file.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	hash, err := fileSHA256(file.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			m.source,
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+	resp.PlanValue = types.StringValue(hash)
+}
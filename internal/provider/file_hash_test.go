@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSHA256(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("hello world"), 0o600))
+
+	hash, err := fileSHA256(path)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", hash)
+}
+
+func TestFileSHA256Missing(t *testing.T) {
+	_, err := fileSHA256(filepath.Join(t.TempDir(), "missing.txt"))
+
+	assert.Error(t, err)
+}
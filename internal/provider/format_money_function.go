@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ function.Function = &FormatMoneyFunction{}
+
+// moneyParameterAttributes are the attributes of money passed to provider
+// functions. Subscription prices and the results of parse_money and
+// convert_region_prices all have them.
+var moneyParameterAttributes = map[string]attr.Type{
+	"currency": types.StringType,
+	"units":    types.Int64Type,
+	"nanos":    types.Int64Type,
+}
+
+type moneyParameterModel struct {
+	Currency string `tfsdk:"currency"`
+	Units    int64  `tfsdk:"units"`
+	Nanos    int64  `tfsdk:"nanos"`
+}
+
+func (m moneyParameterModel) money() *androidpublisher.Money {
+	return &androidpublisher.Money{
+		Units:        m.Units,
+		Nanos:        m.Nanos,
+		CurrencyCode: strings.ToUpper(m.Currency),
+	}
+}
+
+func NewFormatMoneyFunction() function.Function {
+	return &FormatMoneyFunction{}
+}
+
+type FormatMoneyFunction struct{}
+
+func (f *FormatMoneyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_money"
+}
+
+func (f *FormatMoneyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format an amount of money",
+		MarkdownDescription: `Formats money as an amount and currency, such as ` + "`9.99 USD`" + `. Currencies with a minor unit are
+		shown with at least two decimal places, and currencies without one, such as ` + "`JPY`" + `, are shown as whole numbers`,
+
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "money",
+				MarkdownDescription: "The money to format, with `currency`, `units` and `nanos` attributes",
+				AttributeTypes:      moneyParameterAttributes,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatMoneyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var money moneyParameterModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &money))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, formatMoney(money.money())))
+}
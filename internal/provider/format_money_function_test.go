@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMoneyFunction(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.ObjectValueMust(moneyParameterAttributes, map[string]attr.Value{
+				"currency": types.StringValue("gbp"),
+				"units":    types.Int64Value(4),
+				"nanos":    types.Int64Value(500000000),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&FormatMoneyFunction{}).Run(context.Background(), req, &resp)
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("4.50 GBP"), resp.Result.Value())
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,14 +16,38 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	androidpublisher "google.golang.org/api/androidpublisher/v3"
 	"google.golang.org/api/option"
+	playdeveloperreporting "google.golang.org/api/playdeveloperreporting/v1beta1"
 )
 
 var _ provider.Provider = &GooglePlayProvider{}
 
-// GooglePlayClient wraps the official Android Publisher service with a developer ID.
+// GooglePlayClient wraps the official Android Publisher and Play Developer
+// Reporting services with a developer ID.
 type GooglePlayClient struct {
 	service     *androidpublisher.Service
+	reporting   *playdeveloperreporting.Service
 	developerID string
+	edits       editSessions
+
+	// subscriptions serialises changes to the base plans of each subscription,
+	// which are saved by rewriting the whole list.
+	subscriptions editSessions
+
+	// protectedTracks are the tracks where releases need allow_production_changes.
+	protectedTracks []string
+
+	// validateOnly discards edits once they have been validated, instead of
+	// committing them.
+	validateOnly bool
+
+	// changesNotSentForReview commits edits without sending them for review,
+	// unless a resource overrides it.
+	changesNotSentForReview bool
+
+	// regionsVersion is the version of the available regions used when
+	// saving prices, unless a resource overrides it.
+	regionsVersion       string
+	latestRegionsVersion latestRegionsVersion
 }
 
 func (c *GooglePlayClient) ListUsers(ctx context.Context) ([]*androidpublisher.User, error) {
@@ -125,8 +150,12 @@ type GooglePlayProvider struct {
 }
 
 type GooglePlayProviderModel struct {
-	ServiceAccountJson types.String `tfsdk:"service_account_json_base64"`
-	DeveloperID        types.String `tfsdk:"developer_id"`
+	ServiceAccountJson      types.String `tfsdk:"service_account_json_base64"`
+	DeveloperID             types.String `tfsdk:"developer_id"`
+	ProtectedTracks         types.List   `tfsdk:"protected_tracks"`
+	ValidateOnly            types.Bool   `tfsdk:"validate_only"`
+	ChangesNotSentForReview types.Bool   `tfsdk:"changes_not_sent_for_review"`
+	RegionsVersion          types.String `tfsdk:"regions_version"`
 }
 
 func (p *GooglePlayProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -150,6 +179,28 @@ func (p *GooglePlayProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Required:  true,
 				Sensitive: false,
 			},
+			"protected_tracks": schema.ListAttribute{
+				MarkdownDescription: `Tracks where creating or changing a release requires ` + "`allow_production_changes = true`" + ` on the
+				` + "`googleplay_track_release`" + ` resource. Defaults to ` + "`[\"production\"]`",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"validate_only": schema.BoolAttribute{
+				MarkdownDescription: `Validate changes made through edits, such as releases, uploads and store listings, without publishing them.
+				Each edit is checked by Google Play and then deleted instead of being committed, so the Play Console is left untouched`,
+				Optional: true,
+			},
+			"changes_not_sent_for_review": schema.BoolAttribute{
+				MarkdownDescription: `Commit edits without sending the changes for review, so they can be sent from Play Console later.
+				Google Play requires this after an update has been rejected. Resources can override this setting`,
+				Optional: true,
+			},
+			"regions_version": schema.StringAttribute{
+				MarkdownDescription: `The version of the available regions used when saving subscriptions, base plans, offers and one-time products,
+				for example ` + "`2025/03`" + `. Newer versions add regions which can then be priced. Defaults to ` + "`" + defaultRegionsVersion + "`" + `.
+				Resources can override this setting, and a warning is shown when planning changes with an older version than the latest`,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -165,8 +216,23 @@ func (p *GooglePlayProvider) Configure(ctx context.Context, req provider.Configu
 
 	developerID := data.DeveloperID.ValueString()
 
+	resp.Diagnostics.Append(validateRegionsVersion(path.Root("regions_version"), data.RegionsVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protectedTracks := defaultProtectedTracks
+	if !data.ProtectedTracks.IsNull() && !data.ProtectedTracks.IsUnknown() {
+		protectedTracks = []string{}
+		resp.Diagnostics.Append(data.ProtectedTracks.ElementsAs(ctx, &protectedTracks, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	google_credentials := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
 
+	var opts []option.ClientOption
 	if !data.ServiceAccountJson.IsNull() && !data.ServiceAccountJson.IsUnknown() {
 		tflog.Info(ctx, "Using service account from provider configuration")
 
@@ -180,36 +246,9 @@ func (p *GooglePlayProvider) Configure(ctx context.Context, req provider.Configu
 			return
 		}
 
-		service, err := androidpublisher.NewService(ctx, option.WithAuthCredentialsJSON(option.ServiceAccount, rawJson))
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error creating Android Publisher service",
-				err.Error(),
-			)
-			return
-		}
-
-		client := &GooglePlayClient{service: service, developerID: developerID}
-		resp.DataSourceData = client
-		resp.ResourceData = client
+		opts = append(opts, option.WithAuthCredentialsJSON(option.ServiceAccount, rawJson))
 	} else if google_credentials != "" {
 		tflog.Info(ctx, "Using service account from GOOGLE_APPLICATION_CREDENTIALS environment variable")
-
-		service, err := androidpublisher.NewService(ctx)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error creating Android Publisher service",
-				err.Error(),
-			)
-			return
-		}
-
-		tflog.Info(ctx, "created client successfully")
-
-		client := &GooglePlayClient{service: service, developerID: developerID}
-		resp.DataSourceData = client
-		resp.ResourceData = client
-
 	} else {
 		resp.Diagnostics.AddError(
 			"Missing service account JSON",
@@ -217,21 +256,88 @@ func (p *GooglePlayProvider) Configure(ctx context.Context, req provider.Configu
 		)
 		return
 	}
+
+	service, err := androidpublisher.NewService(ctx, opts...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Android Publisher service",
+			err.Error(),
+		)
+		return
+	}
+
+	reporting, err := playdeveloperreporting.NewService(ctx, opts...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Play Developer Reporting service",
+			err.Error(),
+		)
+		return
+	}
+
+	if data.ValidateOnly.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Validate only mode",
+			"validate_only is set, so changes made through edits are validated by Google Play and then discarded. "+
+				"Nothing will be published, but this apply saves the changes to Terraform state as if they had been. "+
+				"The next refresh reads Google Play again: uploads and releases which were never committed are removed from state and planned again, "+
+				"and other changes show up as drift. Use a separate state for validation runs.",
+		)
+	}
+
+	tflog.Info(ctx, "created client successfully")
+
+	client := &GooglePlayClient{
+		service:                 service,
+		reporting:               reporting,
+		developerID:             developerID,
+		protectedTracks:         protectedTracks,
+		validateOnly:            data.ValidateOnly.ValueBool(),
+		changesNotSentForReview: data.ChangesNotSentForReview.ValueBool(),
+		regionsVersion:          data.RegionsVersion.ValueString(),
+	}
+	resp.DataSourceData = client
+	resp.ResourceData = client
 }
 
 func (p *GooglePlayProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUserResource,
 		NewAppIAMResource,
+		NewListingImagesResource,
+		NewTrackReleaseResource,
+		NewBundleResource,
+		NewApkResource,
+		NewDeobfuscationFileResource,
+		NewTrackTestersResource,
+		NewTrackResource,
+		NewInAppProductResource,
+		NewInAppProductsResource,
+		NewSubscriptionResource,
+		NewSubscriptionBasePlanResource,
+		NewSubscriptionOfferResource,
+		NewOneTimeProductResource,
+		NewReviewReplyResource,
+		NewInternalAppSharingArtifactResource,
 	}
 }
 
 func (p *GooglePlayProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewCrashRateDataSource,
+		NewCountryAvailabilityDataSource,
+		NewSubscriptionsDataSource,
+		NewReviewsDataSource,
+	}
 }
 
 func (p *GooglePlayProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewConvertRegionPricesFunction,
+		NewFormatMoneyFunction,
+		NewParseMoneyFunction,
+		NewRoundPriceEndingFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
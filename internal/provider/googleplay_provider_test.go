@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -19,3 +20,13 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccPackageName returns the package name of the app which acceptance tests
+// for app content run against, skipping the test when none is configured.
+func testAccPackageName(t *testing.T) string {
+	packageName := os.Getenv("GOOGLEPLAY_TEST_PACKAGE_NAME")
+	if packageName == "" {
+		t.Skip("GOOGLEPLAY_TEST_PACKAGE_NAME must be set for acceptance tests which modify an app")
+	}
+	return packageName
+}
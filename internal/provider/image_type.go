@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+)
+
+type ImageType string
+
+const (
+	PhoneScreenshots     ImageType = "phoneScreenshots"
+	SevenInchScreenshots ImageType = "sevenInchScreenshots"
+	TenInchScreenshots   ImageType = "tenInchScreenshots"
+	TvScreenshots        ImageType = "tvScreenshots"
+	WearScreenshots      ImageType = "wearScreenshots"
+	Icon                 ImageType = "icon"
+	FeatureGraphic       ImageType = "featureGraphic"
+	TvBanner             ImageType = "tvBanner"
+)
+
+var imageTypes = []ImageType{
+	PhoneScreenshots,
+	SevenInchScreenshots,
+	TenInchScreenshots,
+	TvScreenshots,
+	WearScreenshots,
+	Icon,
+	FeatureGraphic,
+	TvBanner,
+}
+
+// imageRequirements describes the assets Google Play accepts for an image type:
+// https://support.google.com/googleplay/android-developer/answer/9866151
+type imageRequirements struct {
+	maxImages int
+	maxBytes  int64
+
+	// width and height are set when the image type has fixed dimensions.
+	width  int
+	height int
+
+	// Otherwise each side must fall within minSide and maxSide, and the long
+	// side may be at most maxAspect times the short side.
+	minSide   int
+	maxSide   int
+	maxAspect int
+}
+
+const megabyte = 1024 * 1024
+
+func (imageType ImageType) requirements() (imageRequirements, bool) {
+	switch imageType {
+	case PhoneScreenshots,
+		SevenInchScreenshots,
+		TenInchScreenshots,
+		TvScreenshots:
+		return imageRequirements{
+			maxImages: 8,
+			maxBytes:  8 * megabyte,
+			minSide:   320,
+			maxSide:   3840,
+			maxAspect: 2,
+		}, true
+	case WearScreenshots:
+		return imageRequirements{
+			maxImages: 8,
+			maxBytes:  8 * megabyte,
+			minSide:   384,
+			maxSide:   3840,
+			maxAspect: 1,
+		}, true
+	case Icon:
+		return imageRequirements{
+			maxImages: 1,
+			maxBytes:  1 * megabyte,
+			width:     512,
+			height:    512,
+		}, true
+	case FeatureGraphic:
+		return imageRequirements{
+			maxImages: 1,
+			maxBytes:  15 * megabyte,
+			width:     1024,
+			height:    500,
+		}, true
+	case TvBanner:
+		return imageRequirements{
+			maxImages: 1,
+			maxBytes:  15 * megabyte,
+			width:     1280,
+			height:    720,
+		}, true
+	default:
+		return imageRequirements{}, false
+	}
+}
+
+// ValidateCount checks that Google Play accepts count images of this type.
+func (imageType ImageType) ValidateCount(count int) error {
+	requirements, ok := imageType.requirements()
+	if !ok {
+		return fmt.Errorf("unsupported image type '%s'", imageType)
+	}
+	if count == 0 {
+		return fmt.Errorf("at least one image is required")
+	}
+	if count > requirements.maxImages {
+		return fmt.Errorf(
+			"%s accepts at most %d image(s), but %d were given",
+			imageType, requirements.maxImages, count,
+		)
+	}
+	return nil
+}
+
+// ValidateFile checks the size, format and dimensions of the image at path
+// before it is uploaded.
+func (imageType ImageType) ValidateFile(path string) error {
+	requirements, ok := imageType.requirements()
+	if !ok {
+		return fmt.Errorf("unsupported image type '%s'", imageType)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() > requirements.maxBytes {
+		return fmt.Errorf(
+			"%s is %d bytes, but %s images may be at most %d bytes",
+			path, info.Size(), imageType, requirements.maxBytes,
+		)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	config, format, err := image.DecodeConfig(file)
+	if err != nil {
+		return fmt.Errorf("%s is not a PNG or JPEG image: %w", path, err)
+	}
+	if format != "png" && format != "jpeg" {
+		return fmt.Errorf("%s is a %s image, but only PNG and JPEG are supported", path, format)
+	}
+
+	if requirements.width != 0 {
+		if config.Width != requirements.width || config.Height != requirements.height {
+			return fmt.Errorf(
+				"%s is %dx%d, but %s images must be %dx%d",
+				path, config.Width, config.Height,
+				imageType, requirements.width, requirements.height,
+			)
+		}
+		return nil
+	}
+
+	short, long := min(config.Width, config.Height), max(config.Width, config.Height)
+	if short < requirements.minSide || long > requirements.maxSide {
+		return fmt.Errorf(
+			"%s is %dx%d, but each side of %s images must be between %d and %d pixels",
+			path, config.Width, config.Height,
+			imageType, requirements.minSide, requirements.maxSide,
+		)
+	}
+	if long > short*requirements.maxAspect {
+		return fmt.Errorf(
+			"%s is %dx%d, but the long side of %s images may be at most %d times the short side",
+			path, config.Width, config.Height, imageType, requirements.maxAspect,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestImage(t *testing.T, width int, height int) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "image.png")
+	file, err := os.Create(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	assert.NoError(t, png.Encode(file, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return path
+}
+
+func TestValidateIcon(t *testing.T) {
+	assert.NoError(t, Icon.ValidateFile(writeTestImage(t, 512, 512)))
+}
+
+func TestValidateIconWithWrongDimensions(t *testing.T) {
+	assert.ErrorContains(
+		t,
+		Icon.ValidateFile(writeTestImage(t, 256, 256)),
+		"icon images must be 512x512",
+	)
+}
+
+func TestValidateFeatureGraphic(t *testing.T) {
+	assert.NoError(t, FeatureGraphic.ValidateFile(writeTestImage(t, 1024, 500)))
+}
+
+func TestValidatePhoneScreenshot(t *testing.T) {
+	assert.NoError(t, PhoneScreenshots.ValidateFile(writeTestImage(t, 1080, 1920)))
+}
+
+func TestValidatePhoneScreenshotTooSmall(t *testing.T) {
+	assert.ErrorContains(
+		t,
+		PhoneScreenshots.ValidateFile(writeTestImage(t, 200, 400)),
+		"must be between 320 and 3840 pixels",
+	)
+}
+
+func TestValidatePhoneScreenshotAspectRatio(t *testing.T) {
+	assert.ErrorContains(
+		t,
+		PhoneScreenshots.ValidateFile(writeTestImage(t, 400, 1200)),
+		"at most 2 times the short side",
+	)
+}
+
+func TestValidateWearScreenshotMustBeSquare(t *testing.T) {
+	assert.Error(t, WearScreenshots.ValidateFile(writeTestImage(t, 400, 500)))
+	assert.NoError(t, WearScreenshots.ValidateFile(writeTestImage(t, 400, 400)))
+}
+
+func TestValidateFileNotAnImage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "image.png")
+	assert.NoError(t, os.WriteFile(path, []byte("not an image"), 0o600))
+
+	assert.ErrorContains(t, Icon.ValidateFile(path), "is not a PNG or JPEG image")
+}
+
+func TestValidateFileMissing(t *testing.T) {
+	assert.Error(t, Icon.ValidateFile(filepath.Join(t.TempDir(), "missing.png")))
+}
+
+func TestValidateCount(t *testing.T) {
+	assert.NoError(t, PhoneScreenshots.ValidateCount(8))
+	assert.Error(t, PhoneScreenshots.ValidateCount(9))
+	assert.NoError(t, Icon.ValidateCount(1))
+	assert.Error(t, Icon.ValidateCount(2))
+	assert.Error(t, Icon.ValidateCount(0))
+}
+
+func TestValidateUnsupportedImageType(t *testing.T) {
+	assert.Error(t, ImageType("banner").ValidateCount(1))
+}
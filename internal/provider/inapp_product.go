@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// inAppProductStatuses are the states a managed product can be in.
+var inAppProductStatuses = []string{"active", "inactive"}
+
+// InAppProduct describes a managed one-time product. It is shared by the
+// googleplay_inapp_product and googleplay_inapp_products resources.
+type InAppProduct struct {
+	Status          types.String                        `tfsdk:"status"`
+	DefaultLanguage types.String                        `tfsdk:"default_language"`
+	DefaultPrice    *priceModel                         `tfsdk:"default_price"`
+	Prices          map[string]priceModel               `tfsdk:"prices"`
+	Listings        map[string]inAppProductListingModel `tfsdk:"listings"`
+}
+
+// priceModel is the price of an in-app product. It is configured with either
+// amount, or price_micros and currency.
+type priceModel struct {
+	Amount      moneyAmount  `tfsdk:"amount"`
+	PriceMicros types.Int64  `tfsdk:"price_micros"`
+	Currency    types.String `tfsdk:"currency"`
+}
+
+type inAppProductListingModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Benefits    types.List   `tfsdk:"benefits"`
+}
+
+func inAppProductAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Whether the product can be purchased, one of: " + strings.Join(inAppProductStatuses, ", ") + ". Defaults to `active`",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("active"),
+		},
+		"default_language": schema.StringAttribute{
+			MarkdownDescription: "The BCP-47 language tag of the default listing, for example `en-GB`. `listings` must include this language",
+			Required:            true,
+		},
+		"default_price": schema.SingleNestedAttribute{
+			MarkdownDescription: "The price of the product in its default currency. Set either `amount`, or `price_micros` and `currency`",
+			Attributes:          priceAttributes(),
+			PlanModifiers: []planmodifier.Object{
+				moneyPlanModifier[priceModel]{},
+			},
+			Required: true,
+		},
+		"prices": schema.MapNestedAttribute{
+			MarkdownDescription: `Prices for specific regions, keyed by ISO 3166 region code, for example ` + "`US`" + `.
+			Only the regions set here are managed, so regions that Google Play converts from the default price are not reported as changes`,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: priceAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					moneyPlanModifier[priceModel]{},
+				},
+			},
+			Optional: true,
+		},
+		"listings": schema.MapNestedAttribute{
+			MarkdownDescription: "The title and description of the product, keyed by BCP-47 language tag",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the product",
+						Required:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "The description of the product",
+						Required:            true,
+					},
+					"benefits": schema.ListAttribute{
+						MarkdownDescription: "Up to four benefits of the product",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			Required: true,
+		},
+	}
+}
+
+func priceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"amount": schema.StringAttribute{
+			MarkdownDescription: "The price and its currency, for example `0.99 USD`",
+			CustomType:          moneyAmountType{},
+			Optional:            true,
+			Computed:            true,
+		},
+		"price_micros": schema.Int64Attribute{
+			MarkdownDescription: "The price in millionths of the currency unit, for example `990000` for 0.99",
+			Optional:            true,
+			Computed:            true,
+		},
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "The ISO 4217 currency code, for example `USD`",
+			Optional:            true,
+			Computed:            true,
+		},
+	}
+}
+
+// Validate returns a problem with each known value of the product which
+// Google Play would reject.
+func (p InAppProduct) Validate() []string {
+	problems := []string{}
+
+	status := p.Status.ValueString()
+	if !p.Status.IsUnknown() && !p.Status.IsNull() && !slices.Contains(inAppProductStatuses, status) {
+		problems = append(problems, fmt.Sprintf(
+			"'%s' is not a valid status, expected one of: %s.", status, strings.Join(inAppProductStatuses, ", "),
+		))
+	}
+
+	language := p.DefaultLanguage.ValueString()
+	if !p.DefaultLanguage.IsUnknown() && p.Listings != nil {
+		if _, ok := p.Listings[language]; !ok {
+			problems = append(problems, fmt.Sprintf("listings must include the default language, %s.", language))
+		}
+	}
+
+	prices := map[string]priceModel{}
+	if p.DefaultPrice != nil {
+		prices["default_price"] = *p.DefaultPrice
+	}
+	for region, price := range p.Prices {
+		prices[fmt.Sprintf("prices[%q]", region)] = price
+	}
+	for _, name := range slices.Sorted(maps.Keys(prices)) {
+		price := prices[name]
+		if !price.Amount.IsUnknown() && !price.Amount.IsNull() {
+			money, err := parseMoney(price.Amount.ValueString())
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s has an invalid amount: %s.", name, err))
+			} else if priceMicros(money) <= 0 {
+				problems = append(problems, fmt.Sprintf("%s must be greater than 0.", name))
+			}
+		} else if !price.PriceMicros.IsUnknown() && !price.PriceMicros.IsNull() && price.PriceMicros.ValueInt64() <= 0 {
+			problems = append(problems, fmt.Sprintf("%s must be greater than 0.", name))
+		}
+	}
+
+	for _, language := range slices.Sorted(maps.Keys(p.Listings)) {
+		benefits := p.Listings[language].Benefits
+		if !benefits.IsUnknown() && len(benefits.Elements()) > 4 {
+			problems = append(problems, fmt.Sprintf("the %s listing has %d benefits, but at most 4 are allowed.", language, len(benefits.Elements())))
+		}
+	}
+
+	return problems
+}
+
+// product builds the API representation of the product.
+func (p InAppProduct) product(ctx context.Context, packageName string, sku string) (*androidpublisher.InAppProduct, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	product := &androidpublisher.InAppProduct{
+		PackageName:     packageName,
+		Sku:             sku,
+		Status:          p.Status.ValueString(),
+		PurchaseType:    "managedUser",
+		DefaultLanguage: p.DefaultLanguage.ValueString(),
+		Prices:          map[string]androidpublisher.Price{},
+		Listings:        map[string]androidpublisher.InAppProductListing{},
+	}
+	if p.DefaultPrice != nil {
+		product.DefaultPrice = p.DefaultPrice.price()
+	}
+	for region, price := range p.Prices {
+		product.Prices[region] = *price.price()
+	}
+	for language, listing := range p.Listings {
+		benefits := []string{}
+		diagnostics.Append(listing.Benefits.ElementsAs(ctx, &benefits, false)...)
+		product.Listings[language] = androidpublisher.InAppProductListing{
+			Title:       listing.Title.ValueString(),
+			Description: listing.Description.ValueString(),
+			Benefits:    benefits,
+		}
+	}
+	return product, diagnostics
+}
+
+// setProduct records product, keeping only the regional prices which were
+// already managed. Listings without benefits keep an empty list if one was
+// configured.
+func (p *InAppProduct) setProduct(ctx context.Context, product *androidpublisher.InAppProduct) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	p.Status = types.StringValue(product.Status)
+	p.DefaultLanguage = types.StringValue(product.DefaultLanguage)
+	if product.DefaultPrice != nil {
+		price := priceValue(*product.DefaultPrice, &diagnostics)
+		p.DefaultPrice = &price
+	}
+
+	if p.Prices != nil {
+		prices := map[string]priceModel{}
+		for region := range p.Prices {
+			if price, ok := product.Prices[region]; ok {
+				prices[region] = priceValue(price, &diagnostics)
+			}
+		}
+		p.Prices = prices
+	}
+
+	listings := map[string]inAppProductListingModel{}
+	for language, listing := range product.Listings {
+		benefits := types.ListNull(types.StringType)
+		if prior := p.Listings[language].Benefits; len(listing.Benefits) > 0 || (!prior.IsNull() && !prior.IsUnknown()) {
+			values := listing.Benefits
+			if values == nil {
+				values = []string{}
+			}
+			var diags diag.Diagnostics
+			benefits, diags = types.ListValueFrom(ctx, types.StringType, values)
+			diagnostics.Append(diags...)
+		}
+		listings[language] = inAppProductListingModel{
+			Title:       types.StringValue(listing.Title),
+			Description: types.StringValue(listing.Description),
+			Benefits:    benefits,
+		}
+	}
+	p.Listings = listings
+
+	return diagnostics
+}
+
+func (m priceModel) price() *androidpublisher.Price {
+	return &androidpublisher.Price{
+		PriceMicros: strconv.FormatInt(m.PriceMicros.ValueInt64(), 10),
+		Currency:    m.Currency.ValueString(),
+	}
+}
+
+func priceValue(price androidpublisher.Price, diagnostics *diag.Diagnostics) priceModel {
+	micros, err := strconv.ParseInt(price.PriceMicros, 10, 64)
+	if err != nil {
+		diagnostics.AddError(
+			"Invalid price",
+			fmt.Sprintf("Google Play returned a price of %s %s, which is not a whole number of micros.", price.PriceMicros, price.Currency),
+		)
+	}
+	return priceMicrosValue(micros, price.Currency)
+}
+
+func priceMicrosValue(micros int64, currency string) priceModel {
+	return priceModel{
+		Amount: moneyAmountValue(formatMoney(&androidpublisher.Money{
+			Units:        micros / 1_000_000,
+			Nanos:        micros % 1_000_000 * 1_000,
+			CurrencyCode: currency,
+		})),
+		PriceMicros: types.Int64Value(micros),
+		Currency:    types.StringValue(currency),
+	}
+}
+
+// normalize fills in whichever of amount, or price_micros and currency, were
+// not configured.
+func (m priceModel) normalize() (priceModel, error) {
+	if !m.Amount.IsNull() {
+		if !m.PriceMicros.IsNull() || !m.Currency.IsNull() {
+			return m, fmt.Errorf("set either amount, or price_micros and currency, not both")
+		}
+		money, err := parseMoney(m.Amount.ValueString())
+		if err != nil {
+			return m, err
+		}
+		if money.Nanos%1_000 != 0 {
+			return m, fmt.Errorf("'%s' has more than 6 decimal places", m.Amount.ValueString())
+		}
+		normalized := priceMicrosValue(priceMicros(money), money.CurrencyCode)
+		normalized.Amount = m.Amount
+		return normalized, nil
+	}
+
+	if m.PriceMicros.IsNull() || m.Currency.IsNull() {
+		return m, fmt.Errorf("set either amount, or price_micros and currency")
+	}
+	return priceMicrosValue(m.PriceMicros.ValueInt64(), m.Currency.ValueString()), nil
+}
+
+// Equal reports whether two products have the same values.
+func (p InAppProduct) Equal(other InAppProduct) bool {
+	if !p.Status.Equal(other.Status) || !p.DefaultLanguage.Equal(other.DefaultLanguage) {
+		return false
+	}
+	if (p.DefaultPrice == nil) != (other.DefaultPrice == nil) ||
+		(p.DefaultPrice != nil && !p.DefaultPrice.Equal(*other.DefaultPrice)) {
+		return false
+	}
+	if !maps.EqualFunc(p.Prices, other.Prices, priceModel.Equal) || (p.Prices == nil) != (other.Prices == nil) {
+		return false
+	}
+	return maps.EqualFunc(p.Listings, other.Listings, inAppProductListingModel.Equal)
+}
+
+func (m priceModel) Equal(other priceModel) bool {
+	return m.PriceMicros.Equal(other.PriceMicros) && m.Currency.Equal(other.Currency)
+}
+
+func (m inAppProductListingModel) Equal(other inAppProductListingModel) bool {
+	return m.Title.Equal(other.Title) &&
+		m.Description.Equal(other.Description) &&
+		m.Benefits.Equal(other.Benefits)
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InAppProductResource{}
+var _ resource.ResourceWithImportState = &InAppProductResource{}
+var _ resource.ResourceWithValidateConfig = &InAppProductResource{}
+
+func NewInAppProductResource() resource.Resource {
+	return &InAppProductResource{}
+}
+
+type InAppProductResource struct {
+	client *GooglePlayClient
+}
+
+type inAppProductResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	PackageName              types.String `tfsdk:"package_name"`
+	SKU                      types.String `tfsdk:"sku"`
+	AutoConvertMissingPrices types.Bool   `tfsdk:"auto_convert_missing_prices"`
+	InAppProduct
+}
+
+func (r *InAppProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inapp_product"
+}
+
+func (r *InAppProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the product, in the format `package_name/sku`",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"package_name": schema.StringAttribute{
+			MarkdownDescription: "The package name of the app, for example `com.example.app`",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"sku": schema.StringAttribute{
+			MarkdownDescription: "The stock-keeping unit (SKU) of the product, unique within the app",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"auto_convert_missing_prices": schema.BoolAttribute{
+			MarkdownDescription: "Set prices for regions missing from `prices` by converting `default_price` into each region's currency",
+			Optional:            true,
+		},
+	}
+	maps.Copy(attributes, inAppProductAttributes())
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a managed one-time in-app product",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *InAppProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InAppProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Maps and objects cannot be read until they are known
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data inAppProductResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, problem := range data.Validate() {
+		resp.Diagnostics.AddError("Invalid in-app product", problem)
+	}
+}
+
+func (r *InAppProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/sku
+	components := strings.Split(req.ID, "/")
+	if len(components) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/sku', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sku"), components[1])...)
+}
+
+func (r *InAppProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data inAppProductResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, diags := data.product(ctx, data.PackageName.ValueString(), data.SKU.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, err := r.client.InsertInAppProduct(ctx, product, data.AutoConvertMissingPrices.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create in-app product",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.PackageName.ValueString(), data.SKU.ValueString()))
+	resp.Diagnostics.Append(data.setProduct(ctx, product)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data inAppProductResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, err := r.client.GetInAppProduct(ctx, data.PackageName.ValueString(), data.SKU.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch in-app product",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setProduct(ctx, product)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data inAppProductResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, diags := data.product(ctx, data.PackageName.ValueString(), data.SKU.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, err := r.client.PatchInAppProduct(ctx, product, data.AutoConvertMissingPrices.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update in-app product",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setProduct(ctx, product)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data inAppProductResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteInAppProduct(ctx, data.PackageName.ValueString(), data.SKU.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete in-app product, got error: %s", err))
+		return
+	}
+}
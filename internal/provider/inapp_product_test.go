@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testPrice(micros int64, currency string) priceModel {
+	return priceMicrosValue(micros, currency)
+}
+
+func testInAppProduct() InAppProduct {
+	defaultPrice := testPrice(990000, "GBP")
+	return InAppProduct{
+		Status:          types.StringValue("active"),
+		DefaultLanguage: types.StringValue("en-GB"),
+		DefaultPrice:    &defaultPrice,
+		Prices: map[string]priceModel{
+			"US": testPrice(1290000, "USD"),
+		},
+		Listings: map[string]inAppProductListingModel{
+			"en-GB": {
+				Title:       types.StringValue("100 coins"),
+				Description: types.StringValue("A pile of coins"),
+				Benefits:    types.ListNull(types.StringType),
+			},
+		},
+	}
+}
+
+func TestInAppProductValidate(t *testing.T) {
+	assert.Empty(t, testInAppProduct().Validate())
+
+	product := testInAppProduct()
+	product.Status = types.StringValue("archived")
+	product.DefaultLanguage = types.StringValue("fr-FR")
+	product.Prices["US"] = testPrice(0, "USD")
+	product.Listings["en-GB"] = inAppProductListingModel{
+		Title:       types.StringValue("100 coins"),
+		Description: types.StringValue("A pile of coins"),
+		Benefits: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("1"), types.StringValue("2"), types.StringValue("3"),
+			types.StringValue("4"), types.StringValue("5"),
+		}),
+	}
+	assert.Equal(t, []string{
+		"'archived' is not a valid status, expected one of: active, inactive.",
+		"listings must include the default language, fr-FR.",
+		`prices["US"] must be greater than 0.`,
+		"the en-GB listing has 5 benefits, but at most 4 are allowed.",
+	}, product.Validate())
+}
+
+func TestInAppProductRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	product, diags := testInAppProduct().product(ctx, "com.example.app", "coins_100")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "managedUser", product.PurchaseType)
+	assert.Equal(t, &androidpublisher.Price{PriceMicros: "990000", Currency: "GBP"}, product.DefaultPrice)
+	assert.Equal(t, androidpublisher.Price{PriceMicros: "1290000", Currency: "USD"}, product.Prices["US"])
+
+	// Prices converted by Google Play are not managed
+	product.Prices["FR"] = androidpublisher.Price{PriceMicros: "1090000", Currency: "EUR"}
+
+	model := testInAppProduct()
+	assert.False(t, model.setProduct(ctx, product).HasError())
+	assert.Equal(t, testInAppProduct(), model)
+}
+
+func TestInAppProductEmptyBenefits(t *testing.T) {
+	ctx := context.Background()
+
+	planned := testInAppProduct()
+	planned.Listings["en-GB"] = inAppProductListingModel{
+		Title:       types.StringValue("100 coins"),
+		Description: types.StringValue("A pile of coins"),
+		Benefits:    types.ListValueMust(types.StringType, []attr.Value{}),
+	}
+
+	product, diags := planned.product(ctx, "com.example.app", "coins_100")
+	assert.False(t, diags.HasError())
+	assert.Empty(t, product.Listings["en-GB"].Benefits)
+
+	// Google Play omits benefits when there are none
+	product.Listings["en-GB"] = androidpublisher.InAppProductListing{Title: "100 coins", Description: "A pile of coins"}
+
+	model := planned
+	assert.False(t, model.setProduct(ctx, product).HasError())
+	assert.Equal(t, planned, model)
+
+	// Benefits which were not configured stay null
+	model = testInAppProduct()
+	assert.False(t, model.setProduct(ctx, product).HasError())
+	assert.True(t, model.Listings["en-GB"].Benefits.IsNull())
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &InAppProductsResource{}
+var _ resource.ResourceWithImportState = &InAppProductsResource{}
+var _ resource.ResourceWithValidateConfig = &InAppProductsResource{}
+
+func NewInAppProductsResource() resource.Resource {
+	return &InAppProductsResource{}
+}
+
+type InAppProductsResource struct {
+	client *GooglePlayClient
+}
+
+type inAppProductsResourceModel struct {
+	ID                       types.String            `tfsdk:"id"`
+	PackageName              types.String            `tfsdk:"package_name"`
+	AutoConvertMissingPrices types.Bool              `tfsdk:"auto_convert_missing_prices"`
+	Products                 map[string]InAppProduct `tfsdk:"products"`
+}
+
+func (r *InAppProductsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inapp_products"
+}
+
+func (r *InAppProductsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Manage many managed one-time in-app products at once, using batch requests.
+		Only the SKUs in ` + "`products`" + ` are managed: other products in the app are left alone`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_convert_missing_prices": schema.BoolAttribute{
+				MarkdownDescription: "Set prices for regions missing from each product's `prices` by converting its `default_price` into each region's currency",
+				Optional:            true,
+			},
+			"products": schema.MapNestedAttribute{
+				MarkdownDescription: "The products to manage, keyed by SKU",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: inAppProductAttributes(),
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *InAppProductsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InAppProductsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Maps and objects cannot be read until they are known
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data inAppProductsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, sku := range slices.Sorted(maps.Keys(data.Products)) {
+		for _, problem := range data.Products[sku].Validate() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("products").AtMapKey(sku),
+				"Invalid in-app product",
+				problem,
+			)
+		}
+	}
+}
+
+func (r *InAppProductsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), req.ID)...)
+}
+
+func (r *InAppProductsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data inAppProductsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.PackageName
+	r.syncProducts(ctx, &data, map[string]InAppProduct{}, &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save the products which were created, even if others failed
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppProductsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data inAppProductsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := r.client.ListInAppProducts(ctx, data.PackageName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch in-app products",
+			err.Error(),
+		)
+		return
+	}
+
+	// Imported resources manage every product in the app
+	if data.Products == nil {
+		data.Products = map[string]InAppProduct{}
+		for _, product := range remote {
+			data.Products[product.Sku] = InAppProduct{}
+		}
+	}
+
+	products := map[string]InAppProduct{}
+	for _, product := range remote {
+		managed, ok := data.Products[product.Sku]
+		if !ok {
+			continue
+		}
+		resp.Diagnostics.Append(managed.setProduct(ctx, product)...)
+		products[product.Sku] = managed
+	}
+	data.Products = products
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppProductsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state inAppProductsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.syncProducts(ctx, &data, state.Products, &resp.Diagnostics)
+
+	// Save the products which were updated, even if others failed
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InAppProductsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data inAppProductsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skus := slices.Sorted(maps.Keys(data.Products))
+	failures := r.client.BatchDeleteInAppProducts(ctx, data.PackageName.ValueString(), skus)
+	for _, sku := range slices.Sorted(maps.Keys(failures)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("products").AtMapKey(sku),
+			"Failed to delete in-app product",
+			failures[sku].Error(),
+		)
+	}
+}
+
+// syncProducts brings the products on Google Play in line with data, and
+// records the result. Products which fail to update keep their prior value.
+func (r *InAppProductsResource) syncProducts(
+	ctx context.Context,
+	data *inAppProductsResourceModel,
+	prior map[string]InAppProduct,
+	diagnostics *diag.Diagnostics,
+) {
+	packageName := data.PackageName.ValueString()
+	products, err := r.client.ListInAppProducts(ctx, packageName)
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to fetch in-app products",
+			err.Error(),
+		)
+		data.Products = prior
+		return
+	}
+
+	remote := map[string]*androidpublisher.InAppProduct{}
+	for _, product := range products {
+		remote[product.Sku] = product
+	}
+
+	updates, deletes, diags := diffInAppProducts(ctx, data.Products, prior, remote)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	result := map[string]InAppProduct{}
+	for sku, product := range data.Products {
+		if !slices.Contains(updates, sku) {
+			result[sku] = product
+		}
+	}
+
+	changes := []*androidpublisher.InAppProduct{}
+	for _, sku := range updates {
+		product, diags := data.Products[sku].product(ctx, packageName, sku)
+		diagnostics.Append(diags...)
+		changes = append(changes, product)
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	updated, failures := r.client.BatchUpdateInAppProducts(ctx, packageName, changes, data.AutoConvertMissingPrices.ValueBool())
+	for _, product := range updated {
+		managed := data.Products[product.Sku]
+		diagnostics.Append(managed.setProduct(ctx, product)...)
+		result[product.Sku] = managed
+	}
+
+	deleteFailures := r.client.BatchDeleteInAppProducts(ctx, packageName, deletes)
+	maps.Copy(failures, deleteFailures)
+
+	for _, sku := range slices.Sorted(maps.Keys(failures)) {
+		diagnostics.AddAttributeError(
+			path.Root("products").AtMapKey(sku),
+			"Failed to update in-app product",
+			failures[sku].Error(),
+		)
+		if product, ok := prior[sku]; ok {
+			result[sku] = product
+		}
+	}
+
+	data.Products = result
+}
+
+// diffInAppProducts returns the SKUs of desired products which are missing or
+// different on Google Play, and of prior products which should be deleted.
+func diffInAppProducts(
+	ctx context.Context,
+	desired map[string]InAppProduct,
+	prior map[string]InAppProduct,
+	remote map[string]*androidpublisher.InAppProduct,
+) ([]string, []string, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	updates := []string{}
+	for _, sku := range slices.Sorted(maps.Keys(desired)) {
+		product, ok := remote[sku]
+		if !ok {
+			updates = append(updates, sku)
+			continue
+		}
+
+		current := desired[sku]
+		diagnostics.Append(current.setProduct(ctx, product)...)
+		if !current.Equal(desired[sku]) {
+			updates = append(updates, sku)
+		}
+	}
+
+	deletes := []string{}
+	for _, sku := range slices.Sorted(maps.Keys(prior)) {
+		if _, ok := desired[sku]; ok {
+			continue
+		}
+		if _, ok := remote[sku]; ok {
+			deletes = append(deletes, sku)
+		}
+	}
+
+	return updates, deletes, diagnostics
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestDiffInAppProducts(t *testing.T) {
+	ctx := context.Background()
+
+	unchanged, diags := testInAppProduct().product(ctx, "com.example.app", "unchanged")
+	assert.False(t, diags.HasError())
+	changed, diags := testInAppProduct().product(ctx, "com.example.app", "changed")
+	assert.False(t, diags.HasError())
+	changed.Status = "inactive"
+	removed := &androidpublisher.InAppProduct{Sku: "removed"}
+	unmanaged := &androidpublisher.InAppProduct{Sku: "unmanaged"}
+
+	desired := map[string]InAppProduct{
+		"unchanged": testInAppProduct(),
+		"changed":   testInAppProduct(),
+		"new":       testInAppProduct(),
+	}
+	prior := map[string]InAppProduct{
+		"unchanged": testInAppProduct(),
+		"changed":   testInAppProduct(),
+		"removed":   testInAppProduct(),
+		"gone":      testInAppProduct(),
+	}
+	remote := map[string]*androidpublisher.InAppProduct{
+		"unchanged": unchanged,
+		"changed":   changed,
+		"removed":   removed,
+		"unmanaged": unmanaged,
+	}
+
+	updates, deletes, diags := diffInAppProducts(ctx, desired, prior, remote)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"changed", "new"}, updates)
+	assert.Equal(t, []string{"removed"}, deletes)
+
+	// Comparing against Google Play does not modify the desired products
+	assert.Equal(t, types.StringValue("active"), desired["changed"].Status)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &InternalAppSharingArtifactResource{}
+var _ resource.ResourceWithValidateConfig = &InternalAppSharingArtifactResource{}
+
+func NewInternalAppSharingArtifactResource() resource.Resource {
+	return &InternalAppSharingArtifactResource{}
+}
+
+type InternalAppSharingArtifactResource struct {
+	client *GooglePlayClient
+}
+
+type internalAppSharingArtifactResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	PackageName            types.String `tfsdk:"package_name"`
+	File                   types.String `tfsdk:"file"`
+	SHA256                 types.String `tfsdk:"sha256"`
+	UploadedSHA256         types.String `tfsdk:"uploaded_sha256"`
+	DownloadURL            types.String `tfsdk:"download_url"`
+	CertificateFingerprint types.String `tfsdk:"certificate_fingerprint"`
+}
+
+func (r *InternalAppSharingArtifactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_app_sharing_artifact"
+}
+
+func (r *InternalAppSharingArtifactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Upload an Android App Bundle or APK to internal app sharing, and get a link testers can install it from.
+		Uploads cannot be deleted from Google Play, so destroying this resource only removes it from Terraform state`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the upload, in the format `package_name/sha256`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path to the `.aab` or `.apk` file to upload",
+				Required:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 of the file. The file is uploaded again whenever its contents change",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					hashFilePlanModifier(path.Root("file")),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uploaded_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 Google Play reports for the upload, which is not always the same as `sha256`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"download_url": schema.StringAttribute{
+				MarkdownDescription: "The link testers can install the upload from",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 fingerprint of the certificate used to sign the upload",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InternalAppSharingArtifactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InternalAppSharingArtifactResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var file types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("file"), &file)...)
+
+	if resp.Diagnostics.HasError() || file.IsNull() || file.IsUnknown() {
+		return
+	}
+
+	if extension := strings.ToLower(filepath.Ext(file.ValueString())); extension != ".aab" && extension != ".apk" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Unsupported file type",
+			fmt.Sprintf("Expected an .aab or .apk file, got: %s", file.ValueString()),
+		)
+	}
+}
+
+func (r *InternalAppSharingArtifactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data internalAppSharingArtifactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file := data.File.ValueString()
+	sha256, err := fileSHA256(file)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Unable to read file",
+			err.Error(),
+		)
+		return
+	}
+
+	artifact, err := r.client.UploadInternalAppSharingArtifact(ctx, data.PackageName.ValueString(), file)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to upload to internal app sharing",
+			err.Error(),
+		)
+		return
+	}
+
+	data.setArtifact(artifact, sha256)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InternalAppSharingArtifactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Internal app sharing uploads cannot be read back from Google Play, so
+	// the state recorded when the file was uploaded is kept.
+}
+
+func (r *InternalAppSharingArtifactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data internalAppSharingArtifactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the path to the file has changed: its contents are the same, so
+	// there is nothing to upload.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InternalAppSharingArtifactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Internal app sharing uploads cannot be deleted, so the upload is only
+	// removed from Terraform state.
+	tflog.Info(ctx, "internal app sharing uploads cannot be deleted from Google Play, removing from state only")
+}
+
+// setArtifact records an upload of the file with the local hash sha256. The
+// hash Google Play reports is kept separately, so that sha256 always matches
+// the hash planned from the file.
+func (data *internalAppSharingArtifactResourceModel) setArtifact(artifact *androidpublisher.InternalAppSharingArtifact, sha256 string) {
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.PackageName.ValueString(), sha256))
+	data.SHA256 = types.StringValue(sha256)
+	data.UploadedSHA256 = optionalString(strings.ToLower(artifact.Sha256))
+	data.DownloadURL = types.StringValue(artifact.DownloadUrl)
+	data.CertificateFingerprint = types.StringValue(artifact.CertificateFingerprint)
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestSetInternalAppSharingArtifact(t *testing.T) {
+	data := internalAppSharingArtifactResourceModel{PackageName: types.StringValue("com.example.app")}
+	data.setArtifact(&androidpublisher.InternalAppSharingArtifact{
+		DownloadUrl:            "https://play.google.com/apps/test/abc",
+		CertificateFingerprint: "AB:CD",
+		Sha256:                 "ABCD",
+	}, "ffff")
+
+	assert.Equal(t, "com.example.app/ffff", data.ID.ValueString())
+	assert.Equal(t, "ffff", data.SHA256.ValueString())
+	assert.Equal(t, "abcd", data.UploadedSHA256.ValueString())
+	assert.Equal(t, "https://play.google.com/apps/test/abc", data.DownloadURL.ValueString())
+	assert.Equal(t, "AB:CD", data.CertificateFingerprint.ValueString())
+
+	data.setArtifact(&androidpublisher.InternalAppSharingArtifact{}, "ffff")
+	assert.Equal(t, "ffff", data.SHA256.ValueString())
+	assert.True(t, data.UploadedSHA256.IsNull())
+}
@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &ListingImagesResource{}
+var _ resource.ResourceWithValidateConfig = &ListingImagesResource{}
+var _ resource.ResourceWithImportState = &ListingImagesResource{}
+
+func NewListingImagesResource() resource.Resource {
+	return &ListingImagesResource{}
+}
+
+type ListingImagesResource struct {
+	client *GooglePlayClient
+}
+
+type listingImagesResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PackageName types.String `tfsdk:"package_name"`
+	Language    types.String `tfsdk:"language"`
+	ImageType   types.String `tfsdk:"image_type"`
+	Files       types.List   `tfsdk:"files"`
+	SHA256      types.List   `tfsdk:"sha256"`
+	URLs        types.List   `tfsdk:"urls"`
+
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+func (r *ListingImagesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_listing_images"
+}
+
+func (r *ListingImagesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage the screenshots and graphics of a store listing",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the images, in the format `package_name/language/image_type`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "The BCP-47 language tag of the store listing, for example `en-GB`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_type": schema.StringAttribute{
+				MarkdownDescription: `The type of image, one of: ` + "`" + strings.Join(imageTypeNames(), "`, `") + "`" + `:
+				https://developers.google.com/android-publisher/api-ref/rest/v3/AppImageType`,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.ListAttribute{
+				MarkdownDescription: "Paths to the PNG or JPEG files to upload, in the order they should appear in the listing",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"sha256": schema.ListAttribute{
+				MarkdownDescription: "The SHA-256 of each image, in the same order as `files`",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					hashFilesPlanModifier(path.Root("files")),
+				},
+			},
+			"urls": schema.ListAttribute{
+				MarkdownDescription: "URLs where each uploaded image can be viewed, in the same order as `files`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+	}
+}
+
+func imageTypeNames() []string {
+	names := make([]string, len(imageTypes))
+	for i, imageType := range imageTypes {
+		names[i] = string(imageType)
+	}
+	return names
+}
+
+func (r *ListingImagesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ListingImagesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data listingImagesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ImageType.IsUnknown() || data.ImageType.IsNull() {
+		return
+	}
+
+	imageType := ImageType(data.ImageType.ValueString())
+	if !slices.Contains(imageTypes, imageType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("image_type"),
+			"Invalid image type",
+			fmt.Sprintf(
+				"'%s' is not a supported image type, expected one of: %s.",
+				imageType, strings.Join(imageTypeNames(), ", "),
+			),
+		)
+		return
+	}
+
+	if data.Files.IsUnknown() || data.Files.IsNull() {
+		return
+	}
+
+	if err := imageType.ValidateCount(len(data.Files.Elements())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("files"),
+			"Invalid number of images",
+			err.Error(),
+		)
+	}
+
+	// Check each image before it is uploaded, so mistakes are caught at plan time
+	for i, file := range data.Files.Elements() {
+		file, ok := file.(types.String)
+		if !ok || file.IsUnknown() || file.IsNull() {
+			continue
+		}
+		if err := imageType.ValidateFile(file.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("files").AtListIndex(i),
+				"Invalid image",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (r *ListingImagesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/language/image_type
+	components := strings.Split(req.ID, "/")
+	if len(components) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/language/image_type', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("language"), components[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image_type"), components[2])...)
+}
+
+func (r *ListingImagesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data listingImagesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.syncImages(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ListingImagesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data listingImagesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	language := data.Language.ValueString()
+	imageType := ImageType(data.ImageType.ValueString())
+
+	var images []*androidpublisher.Image
+	err := r.client.ReadEdit(ctx, packageName, func(editID string) error {
+		var err error
+		images, err = r.client.ListImages(ctx, packageName, editID, language, imageType)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch images",
+			err.Error(),
+		)
+		return
+	}
+
+	// The images have been removed outside of Terraform
+	if len(images) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setImages(ctx, images)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ListingImagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data listingImagesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.syncImages(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ListingImagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data listingImagesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	_, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		return r.client.DeleteAllImages(
+			ctx,
+			packageName,
+			editID,
+			data.Language.ValueString(),
+			ImageType(data.ImageType.ValueString()),
+		)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete images, got error: %s", err))
+		return
+	}
+}
+
+// syncImages uploads any images which have changed and records the result in data.
+func (r *ListingImagesResource) syncImages(ctx context.Context, data *listingImagesResourceModel, diagnostics *diag.Diagnostics) {
+	paths := []string{}
+	diagnostics.Append(data.Files.ElementsAs(ctx, &paths, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	hashes := make([]string, len(paths))
+	for i, p := range paths {
+		hash, err := fileSHA256(p)
+		if err != nil {
+			diagnostics.AddAttributeError(
+				path.Root("files").AtListIndex(i),
+				"Unable to read file",
+				err.Error(),
+			)
+			return
+		}
+		hashes[i] = hash
+	}
+
+	packageName := data.PackageName.ValueString()
+	language := data.Language.ValueString()
+	imageType := ImageType(data.ImageType.ValueString())
+
+	var images []*androidpublisher.Image
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		var err error
+		images, err = r.client.SyncImages(ctx, packageName, editID, language, imageType, paths, hashes)
+		return err
+	})
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to upload images",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", packageName, language, imageType))
+	data.SentForReview = types.BoolValue(sentForReview)
+	diagnostics.Append(data.setImages(ctx, images)...)
+}
+
+func (data *listingImagesResourceModel) setImages(ctx context.Context, images []*androidpublisher.Image) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	hashes := make([]string, len(images))
+	urls := make([]string, len(images))
+	for i, image := range images {
+		hashes[i] = strings.ToLower(image.Sha256)
+		urls[i] = image.Url
+	}
+
+	var diag diag.Diagnostics
+	data.SHA256, diag = types.ListValueFrom(ctx, types.StringType, hashes)
+	diagnostics.Append(diag...)
+	data.URLs, diag = types.ListValueFrom(ctx, types.StringType, urls)
+	diagnostics.Append(diag...)
+	return diagnostics
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccListingImagesResource(t *testing.T) {
+	packageName := testAccPackageName(t)
+	icon := writeTestImage(t, 512, 512)
+	screenshot := writeTestImage(t, 1080, 1920)
+	landscape := writeTestImage(t, 1920, 1080)
+
+	iconHash, err := fileSHA256(icon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	screenshotHash, err := fileSHA256(screenshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	landscapeHash, err := fileSHA256(landscape)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read testing
+			{
+				Config: testAccListingImagesResourceConfig(
+					packageName,
+					"icon",
+					fmt.Sprintf(`"%s"`, icon),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"googleplay_listing_images.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(fmt.Sprintf("%s/en-GB/icon", packageName)),
+					),
+					statecheck.ExpectKnownValue(
+						"googleplay_listing_images.test",
+						tfjsonpath.New("sha256"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact(iconHash),
+						}),
+					),
+				},
+			},
+			// Test replacing with screenshots
+			{
+				Config: testAccListingImagesResourceConfig(
+					packageName,
+					"phoneScreenshots",
+					fmt.Sprintf(`"%s", "%s"`, screenshot, landscape),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"googleplay_listing_images.test",
+						tfjsonpath.New("sha256"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact(screenshotHash),
+							knownvalue.StringExact(landscapeHash),
+						}),
+					),
+				},
+			},
+			// Test reordering screenshots
+			{
+				Config: testAccListingImagesResourceConfig(
+					packageName,
+					"phoneScreenshots",
+					fmt.Sprintf(`"%s", "%s"`, landscape, screenshot),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"googleplay_listing_images.test",
+						tfjsonpath.New("sha256"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact(landscapeHash),
+							knownvalue.StringExact(screenshotHash),
+						}),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "googleplay_listing_images.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"files",
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccListingImagesResourceConfig(packageName string, imageType string, files string) string {
+	return fmt.Sprintf(`
+resource "googleplay_listing_images" "test" {
+  package_name = "%s"
+  language     = "en-GB"
+  image_type   = "%s"
+  files = [
+    %s
+  ]
+}
+
+provider "googleplay" {
+  developer_id = "5166846112789481453"
+}`, packageName, imageType, files)
+}
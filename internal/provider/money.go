@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// nanosPerUnit is the number of nanos in one unit of a currency.
+const nanosPerUnit = 1_000_000_000
+
+// zeroDecimalCurrencies have no minor unit, so their prices are whole numbers.
+var zeroDecimalCurrencies = []string{
+	"BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF", "UGX", "VND", "VUV", "XAF", "XOF", "XPF",
+}
+
+// threeDecimalCurrencies have a minor unit of a thousandth, so prices ending
+// in .99 are not conventional for them.
+var threeDecimalCurrencies = []string{
+	"BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND",
+}
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// amountPattern matches a decimal amount, without an exponent or base prefix.
+var amountPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+// moneyObjectType is the type of money returned by provider functions. It has
+// the attributes of both monetization prices and in-app product prices, so it
+// can be used for either.
+var moneyObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"currency":     types.StringType,
+		"units":        types.Int64Type,
+		"nanos":        types.Int64Type,
+		"price_micros": types.Int64Type,
+	},
+}
+
+type moneyObjectModel struct {
+	Currency    types.String `tfsdk:"currency"`
+	Units       types.Int64  `tfsdk:"units"`
+	Nanos       types.Int64  `tfsdk:"nanos"`
+	PriceMicros types.Int64  `tfsdk:"price_micros"`
+}
+
+func moneyObjectValue(money *androidpublisher.Money) moneyObjectModel {
+	return moneyObjectModel{
+		Currency:    types.StringValue(money.CurrencyCode),
+		Units:       types.Int64Value(money.Units),
+		Nanos:       types.Int64Value(money.Nanos),
+		PriceMicros: types.Int64Value(priceMicros(money)),
+	}
+}
+
+// moneyModel is an amount of money, as used by the monetization APIs. It is
+// configured with either amount, or units, nanos and currency.
+type moneyModel struct {
+	Amount   moneyAmount  `tfsdk:"amount"`
+	Units    types.Int64  `tfsdk:"units"`
+	Nanos    types.Int64  `tfsdk:"nanos"`
+	Currency types.String `tfsdk:"currency"`
+}
+
+func moneyAttribute(description string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description + ". Set either `amount`, or `units` and `currency`",
+		Attributes: map[string]schema.Attribute{
+			"amount": schema.StringAttribute{
+				MarkdownDescription: "The amount and its currency, for example `9.99 USD`",
+				CustomType:          moneyAmountType{},
+				Optional:            true,
+				Computed:            true,
+			},
+			"units": schema.Int64Attribute{
+				MarkdownDescription: "The whole units of the amount, for example `9` for 9.99",
+				Optional:            true,
+				Computed:            true,
+			},
+			"nanos": schema.Int64Attribute{
+				MarkdownDescription: "The billionths of a unit in the amount, for example `990000000` for 9.99. Defaults to `0`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The ISO 4217 currency code, for example `USD`",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			moneyPlanModifier[moneyModel]{},
+		},
+		Required: required,
+		Optional: !required,
+	}
+}
+
+func (m moneyModel) money() *androidpublisher.Money {
+	return &androidpublisher.Money{
+		Units:        m.Units.ValueInt64(),
+		Nanos:        m.Nanos.ValueInt64(),
+		CurrencyCode: m.Currency.ValueString(),
+	}
+}
+
+func moneyValue(money *androidpublisher.Money) moneyModel {
+	return moneyModel{
+		Amount:   moneyAmountValue(formatMoney(money)),
+		Units:    types.Int64Value(money.Units),
+		Nanos:    types.Int64Value(money.Nanos),
+		Currency: types.StringValue(money.CurrencyCode),
+	}
+}
+
+// normalize fills in whichever of amount, or units, nanos and currency, were
+// not configured.
+func (m moneyModel) normalize() (moneyModel, error) {
+	if !m.Amount.IsNull() {
+		if !m.Units.IsNull() || !m.Nanos.IsNull() || !m.Currency.IsNull() {
+			return m, fmt.Errorf("set either amount, or units and currency, not both")
+		}
+		money, err := parseMoney(m.Amount.ValueString())
+		if err != nil {
+			return m, err
+		}
+		normalized := moneyValue(money)
+		normalized.Amount = m.Amount
+		return normalized, nil
+	}
+
+	if m.Units.IsNull() || m.Currency.IsNull() {
+		return m, fmt.Errorf("set either amount, or units and currency")
+	}
+	if m.Nanos.IsNull() {
+		m.Nanos = types.Int64Value(0)
+	}
+	return moneyValue(m.money()), nil
+}
+
+// parseMoney parses an amount and currency, such as "9.99 USD".
+func parseMoney(value string) (*androidpublisher.Money, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("expected an amount and currency, such as '9.99 USD', got: '%s'", value)
+	}
+
+	if !amountPattern.MatchString(fields[0]) {
+		return nil, fmt.Errorf("'%s' is not a decimal amount", fields[0])
+	}
+	amount, _ := new(big.Rat).SetString(fields[0])
+	nanos := new(big.Rat).Mul(amount, new(big.Rat).SetInt64(nanosPerUnit))
+	if !nanos.IsInt() {
+		return nil, fmt.Errorf("'%s' has more than 9 decimal places", fields[0])
+	}
+
+	currency := strings.ToUpper(fields[1])
+	if !currencyCodePattern.MatchString(currency) {
+		return nil, fmt.Errorf("'%s' is not an ISO 4217 currency code", fields[1])
+	}
+
+	units, remainder := new(big.Int).QuoRem(nanos.Num(), big.NewInt(nanosPerUnit), new(big.Int))
+	if !units.IsInt64() {
+		return nil, fmt.Errorf("'%s' is too large", fields[0])
+	}
+	return &androidpublisher.Money{
+		Units:        units.Int64(),
+		Nanos:        remainder.Int64(),
+		CurrencyCode: currency,
+	}, nil
+}
+
+// formatMoney formats money as an amount and currency, such as "9.99 USD".
+// Currencies with a minor unit are shown with at least two decimal places.
+func formatMoney(money *androidpublisher.Money) string {
+	nanos := money.Nanos
+	sign := ""
+	units := money.Units
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	decimals := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	if len(decimals) < 2 && !slices.Contains(zeroDecimalCurrencies, money.CurrencyCode) {
+		decimals += strings.Repeat("0", 2-len(decimals))
+	}
+	if decimals != "" {
+		decimals = "." + decimals
+	}
+	return fmt.Sprintf("%s%d%s %s", sign, units, decimals, money.CurrencyCode)
+}
+
+// roundPriceEnding rounds money to the nearest amount ending in .99, or to a
+// whole number of units for currencies without a minor unit. This is only a
+// heuristic: it does not know the price points Google Play uses in each
+// region, and has no convention for currencies with three decimal places.
+func roundPriceEnding(money *androidpublisher.Money) (*androidpublisher.Money, error) {
+	if slices.Contains(threeDecimalCurrencies, money.CurrencyCode) {
+		return nil, fmt.Errorf("%s has three decimal places, so there is no .99 price ending to round to", money.CurrencyCode)
+	}
+
+	rounded := &androidpublisher.Money{CurrencyCode: money.CurrencyCode}
+	total := money.Units*nanosPerUnit + money.Nanos
+
+	if slices.Contains(zeroDecimalCurrencies, money.CurrencyCode) {
+		rounded.Units = (total + nanosPerUnit/2) / nanosPerUnit
+		return rounded, nil
+	}
+
+	// Find the nearest whole number of units, less one cent
+	const cent = nanosPerUnit / 100
+	units := (total + cent + nanosPerUnit/2) / nanosPerUnit
+	if units < 1 {
+		units = 1
+	}
+	rounded.Units = units - 1
+	rounded.Nanos = nanosPerUnit - cent
+	return rounded, nil
+}
+
+// splitAmount splits a decimal amount into whole units and nanos, rounding to
+// the nearest nano.
+func splitAmount(amount *big.Float) (int64, int64) {
+	scaled := new(big.Float).Mul(amount, big.NewFloat(nanosPerUnit))
+	half := big.NewFloat(0.5)
+	if scaled.Sign() < 0 {
+		half.Neg(half)
+	}
+	total, _ := scaled.Add(scaled, half).Int(nil)
+
+	units, nanos := new(big.Int).QuoRem(total, big.NewInt(nanosPerUnit), new(big.Int))
+	return units.Int64(), nanos.Int64()
+}
+
+// priceMicros converts money to the millionths of a unit used by in-app
+// product prices.
+func priceMicros(money *androidpublisher.Money) int64 {
+	return money.Units*1_000_000 + money.Nanos/1_000
+}
+
+// normalizer is money which can fill in the attributes that were not
+// configured from those that were.
+type normalizer[T any] interface {
+	normalize() (T, error)
+}
+
+// moneyPlanModifier plans every attribute of a money object from whichever
+// attributes were configured, so either form can be used without a diff.
+type moneyPlanModifier[T normalizer[T]] struct{}
+
+func (m moneyPlanModifier[T]) Description(ctx context.Context) string {
+	return "Fills in the amount of money from its other attributes, or the other attributes from the amount."
+}
+
+func (m moneyPlanModifier[T]) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m moneyPlanModifier[T]) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || !isFullyKnown(req.ConfigValue) {
+		return
+	}
+
+	var config T
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, err := config.normalize()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid amount of money", err.Error())
+		return
+	}
+
+	value, diags := types.ObjectValueFrom(ctx, req.PlanValue.AttributeTypes(ctx), planned)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value
+
+	// Keep the prior amount if it is the same money written differently.
+	// Terraform requires a configured amount to be planned as written.
+	configured := req.ConfigValue.Attributes()["amount"]
+	if configured.IsNull() && !req.StateValue.IsNull() && sameMoney(ctx, req.StateValue, value) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// sameMoney reports whether two money objects have the same attributes, other
+// than differently formatted amounts of the same money.
+func sameMoney(ctx context.Context, a types.Object, b types.Object) bool {
+	other := b.Attributes()
+	for name, value := range a.Attributes() {
+		if amount, ok := value.(moneyAmount); ok {
+			if equal, _ := amount.StringSemanticEquals(ctx, other[name].(basetypes.StringValuable)); !equal {
+				return false
+			}
+		} else if !value.Equal(other[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFullyKnown(value types.Object) bool {
+	for _, attribute := range value.Attributes() {
+		if attribute.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// moneyAmountType is a string holding an amount and currency, such as
+// "9.99 USD". Amounts are equal if they hold the same money, so "9.9 USD" and
+// the "9.90 USD" returned by Google Play are not reported as a change.
+type moneyAmountType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = moneyAmountType{}
+
+func (t moneyAmountType) Equal(o attr.Type) bool {
+	other, ok := o.(moneyAmountType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t moneyAmountType) String() string {
+	return "moneyAmountType"
+}
+
+func (t moneyAmountType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return moneyAmount{StringValue: in}, nil
+}
+
+func (t moneyAmountType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+	return moneyAmount{StringValue: stringValue}, nil
+}
+
+func (t moneyAmountType) ValueType(ctx context.Context) attr.Value {
+	return moneyAmount{}
+}
+
+type moneyAmount struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = moneyAmount{}
+
+func moneyAmountValue(value string) moneyAmount {
+	return moneyAmount{StringValue: types.StringValue(value)}
+}
+
+func (v moneyAmount) Equal(o attr.Value) bool {
+	other, ok := o.(moneyAmount)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v moneyAmount) Type(ctx context.Context) attr.Type {
+	return moneyAmountType{}
+}
+
+func (v moneyAmount) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	other, ok := newValuable.(moneyAmount)
+	if !ok {
+		return false, nil
+	}
+
+	prior, err := parseMoney(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	next, err := parseMoney(other.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	return prior.Units == next.Units && prior.Nanos == next.Nanos && prior.CurrencyCode == next.CurrencyCode, nil
+}
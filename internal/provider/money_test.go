@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func TestSplitAmount(t *testing.T) {
+	for amount, expected := range map[string][2]int64{
+		"9.99":         {9, 990000000},
+		"10":           {10, 0},
+		"0.0000000015": {0, 2},
+		"1234.5":       {1234, 500000000},
+	} {
+		value, _, err := big.ParseFloat(amount, 10, 512, big.ToNearestEven)
+		assert.NoError(t, err)
+
+		units, nanos := splitAmount(value)
+		assert.Equal(t, expected, [2]int64{units, nanos}, amount)
+	}
+}
+
+func TestPriceMicros(t *testing.T) {
+	assert.Equal(t, int64(9990000), priceMicros(&androidpublisher.Money{Units: 9, Nanos: 990000000}))
+}
+
+func TestParseMoney(t *testing.T) {
+	for amount, expected := range map[string]androidpublisher.Money{
+		"9.99 USD":   {Units: 9, Nanos: 990000000, CurrencyCode: "USD"},
+		"1500 jpy":   {Units: 1500, CurrencyCode: "JPY"},
+		" 0.5  EUR ": {Nanos: 500000000, CurrencyCode: "EUR"},
+	} {
+		money, err := parseMoney(amount)
+		assert.NoError(t, err, amount)
+		assert.Equal(t, expected, *money, amount)
+	}
+
+	for amount, message := range map[string]string{
+		"9.99":                     "expected an amount and currency, such as '9.99 USD', got: '9.99'",
+		"nine USD":                 "'nine' is not a decimal amount",
+		"1e3 USD":                  "'1e3' is not a decimal amount",
+		"0x10 USD":                 "'0x10' is not a decimal amount",
+		"0b1 USD":                  "'0b1' is not a decimal amount",
+		"1/2 USD":                  "'1/2' is not a decimal amount",
+		"99999999999999999999 USD": "'99999999999999999999' is too large",
+		"0.0000000001 USD":         "'0.0000000001' has more than 9 decimal places",
+		"9.99 dollars":             "'dollars' is not an ISO 4217 currency code",
+	} {
+		_, err := parseMoney(amount)
+		assert.EqualError(t, err, message, amount)
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	for expected, money := range map[string]androidpublisher.Money{
+		"9.99 USD":        {Units: 9, Nanos: 990000000, CurrencyCode: "USD"},
+		"10.00 GBP":       {Units: 10, CurrencyCode: "GBP"},
+		"0.50 EUR":        {Nanos: 500000000, CurrencyCode: "EUR"},
+		"1.234567 USD":    {Units: 1, Nanos: 234567000, CurrencyCode: "USD"},
+		"1500 JPY":        {Units: 1500, CurrencyCode: "JPY"},
+		"-1.50 USD":       {Units: -1, Nanos: -500000000, CurrencyCode: "USD"},
+		"0.000000001 USD": {Nanos: 1, CurrencyCode: "USD"},
+	} {
+		assert.Equal(t, expected, formatMoney(&money))
+	}
+}
+
+func TestRoundPriceEnding(t *testing.T) {
+	for _, test := range [][2]androidpublisher.Money{
+		{{Units: 9, Nanos: 870000000, CurrencyCode: "USD"}, {Units: 9, Nanos: 990000000, CurrencyCode: "USD"}},
+		{{Units: 10, Nanos: 400000000, CurrencyCode: "GBP"}, {Units: 9, Nanos: 990000000, CurrencyCode: "GBP"}},
+		{{Units: 10, Nanos: 600000000, CurrencyCode: "GBP"}, {Units: 10, Nanos: 990000000, CurrencyCode: "GBP"}},
+		{{Nanos: 100000000, CurrencyCode: "EUR"}, {Nanos: 990000000, CurrencyCode: "EUR"}},
+		{{Units: 1499, Nanos: 500000000, CurrencyCode: "JPY"}, {Units: 1500, CurrencyCode: "JPY"}},
+	} {
+		rounded, err := roundPriceEnding(&test[0])
+		assert.NoError(t, err)
+		assert.Equal(t, test[1], *rounded, formatMoney(&test[0]))
+	}
+
+	_, err := roundPriceEnding(&androidpublisher.Money{Units: 1, Nanos: 450000000, CurrencyCode: "KWD"})
+	assert.Error(t, err)
+}
+
+func TestMoneyNormalize(t *testing.T) {
+	// Amounts keep their configured format, so there is no diff against config
+	normalized, err := moneyModel{
+		Amount:   moneyAmountValue("4.5 gbp"),
+		Units:    types.Int64Null(),
+		Nanos:    types.Int64Null(),
+		Currency: types.StringNull(),
+	}.normalize()
+	assert.NoError(t, err)
+	assert.Equal(t, moneyModel{
+		Amount:   moneyAmountValue("4.5 gbp"),
+		Units:    types.Int64Value(4),
+		Nanos:    types.Int64Value(500000000),
+		Currency: types.StringValue("GBP"),
+	}, normalized)
+
+	normalized, err = moneyModel{
+		Amount:   moneyAmount{StringValue: types.StringNull()},
+		Units:    types.Int64Value(4),
+		Nanos:    types.Int64Null(),
+		Currency: types.StringValue("GBP"),
+	}.normalize()
+	assert.NoError(t, err)
+	assert.Equal(t, moneyValue(&androidpublisher.Money{Units: 4, CurrencyCode: "GBP"}), normalized)
+
+	_, err = moneyModel{
+		Amount:   moneyAmountValue("4.00 GBP"),
+		Units:    types.Int64Value(4),
+		Nanos:    types.Int64Null(),
+		Currency: types.StringNull(),
+	}.normalize()
+	assert.EqualError(t, err, "set either amount, or units and currency, not both")
+}
+
+func TestPriceNormalize(t *testing.T) {
+	normalized, err := priceModel{
+		Amount:      moneyAmountValue("0.99 USD"),
+		PriceMicros: types.Int64Null(),
+		Currency:    types.StringNull(),
+	}.normalize()
+	assert.NoError(t, err)
+	assert.Equal(t, testPrice(990000, "USD"), normalized)
+
+	_, err = priceModel{
+		Amount:      moneyAmountValue("0.9999999 USD"),
+		PriceMicros: types.Int64Null(),
+		Currency:    types.StringNull(),
+	}.normalize()
+	assert.EqualError(t, err, "'0.9999999 USD' has more than 6 decimal places")
+}
+
+func TestMoneyAmountSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal, diags := moneyAmountValue("9.9 usd").StringSemanticEquals(ctx, moneyAmountValue("9.90 USD"))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	equal, _ = moneyAmountValue("9.90 USD").StringSemanticEquals(ctx, moneyAmountValue("9.90 EUR"))
+	assert.False(t, equal)
+}
@@ -0,0 +1,1256 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &OneTimeProductResource{}
+var _ resource.ResourceWithImportState = &OneTimeProductResource{}
+var _ resource.ResourceWithValidateConfig = &OneTimeProductResource{}
+var _ resource.ResourceWithModifyPlan = &OneTimeProductResource{}
+var _ resource.ResourceWithMoveState = &OneTimeProductResource{}
+
+// purchaseOptionAvailabilities control whether a purchase option can be
+// bought in a region.
+var purchaseOptionAvailabilities = []string{"AVAILABLE", "NO_LONGER_AVAILABLE", "AVAILABLE_IF_RELEASED", "AVAILABLE_FOR_OFFERS_ONLY"}
+
+// regionAvailabilities control whether offers, and purchase options in
+// regions Google Play adds in future, are available.
+var regionAvailabilities = []string{"AVAILABLE", "NO_LONGER_AVAILABLE"}
+
+func NewOneTimeProductResource() resource.Resource {
+	return &OneTimeProductResource{}
+}
+
+type OneTimeProductResource struct {
+	client *GooglePlayClient
+}
+
+type oneTimeProductResourceModel struct {
+	ID                         types.String                          `tfsdk:"id"`
+	PackageName                types.String                          `tfsdk:"package_name"`
+	ProductID                  types.String                          `tfsdk:"product_id"`
+	RegionsVersion             types.String                          `tfsdk:"regions_version"`
+	Listings                   map[string]oneTimeProductListingModel `tfsdk:"listings"`
+	PurchaseOptions            map[string]purchaseOptionModel        `tfsdk:"purchase_options"`
+	TaxAndCompliance           *oneTimeProductTaxModel               `tfsdk:"tax_and_compliance"`
+	RestrictedPaymentCountries types.Set                             `tfsdk:"restricted_payment_countries"`
+	OfferTags                  types.Set                             `tfsdk:"offer_tags"`
+}
+
+type oneTimeProductListingModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+type oneTimeProductTaxModel struct {
+	TokenizedDigitalAsset  types.Bool              `tfsdk:"tokenized_digital_asset"`
+	ProductTaxCategoryCode types.String            `tfsdk:"product_tax_category_code"`
+	TaxRates               map[string]taxRateModel `tfsdk:"tax_rates"`
+}
+
+type purchaseOptionModel struct {
+	State                  types.String                         `tfsdk:"state"`
+	Buy                    *buyPurchaseOptionModel              `tfsdk:"buy"`
+	Rent                   *rentPurchaseOptionModel             `tfsdk:"rent"`
+	RegionalConfigs        map[string]purchaseOptionRegionModel `tfsdk:"regional_configs"`
+	OtherRegions           *purchaseOptionOtherRegionsModel     `tfsdk:"other_regions"`
+	EEAWithdrawalRightType types.String                         `tfsdk:"eea_withdrawal_right_type"`
+	OfferTags              types.Set                            `tfsdk:"offer_tags"`
+	Offers                 map[string]oneTimeOfferModel         `tfsdk:"offers"`
+}
+
+type buyPurchaseOptionModel struct {
+	LegacyCompatible types.Bool `tfsdk:"legacy_compatible"`
+	MultiQuantity    types.Bool `tfsdk:"multi_quantity"`
+}
+
+type rentPurchaseOptionModel struct {
+	RentalPeriod     types.String `tfsdk:"rental_period"`
+	ExpirationPeriod types.String `tfsdk:"expiration_period"`
+}
+
+type purchaseOptionRegionModel struct {
+	Price        moneyModel   `tfsdk:"price"`
+	Availability types.String `tfsdk:"availability"`
+}
+
+type purchaseOptionOtherRegionsModel struct {
+	USDPrice     moneyModel   `tfsdk:"usd_price"`
+	EURPrice     moneyModel   `tfsdk:"eur_price"`
+	Availability types.String `tfsdk:"availability"`
+}
+
+type oneTimeOfferModel struct {
+	State           types.String                       `tfsdk:"state"`
+	StartTime       types.String                       `tfsdk:"start_time"`
+	EndTime         types.String                       `tfsdk:"end_time"`
+	RedemptionLimit types.Int64                        `tfsdk:"redemption_limit"`
+	RegionalConfigs map[string]oneTimeOfferRegionModel `tfsdk:"regional_configs"`
+	OfferTags       types.Set                          `tfsdk:"offer_tags"`
+}
+
+type oneTimeOfferRegionModel struct {
+	AbsoluteDiscount *moneyModel   `tfsdk:"absolute_discount"`
+	RelativeDiscount types.Float64 `tfsdk:"relative_discount"`
+	NoOverride       types.Bool    `tfsdk:"no_override"`
+	Availability     types.String  `tfsdk:"availability"`
+}
+
+func (r *OneTimeProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_onetime_product"
+}
+
+func availabilityAttribute(description string, values []string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description + ", one of: " + strings.Join(values, ", ") + ". Defaults to `AVAILABLE`",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("AVAILABLE"),
+	}
+}
+
+func offerTagsAttribute(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: description,
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+}
+
+func (r *OneTimeProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Manage a one-time product with the monetization API, which replaces
+		` + "`googleplay_inapp_product`" + `. Each product is sold through one or more purchase options, to buy or rent it,
+		which can each have discounted offers`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the product, in the format `package_name/product_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the product, unique within the app. For products created with the in-app products API, this is the SKU",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"regions_version": regionsVersionAttribute("product and its offers"),
+			"listings": schema.MapNestedAttribute{
+				MarkdownDescription: "The title and description of the product shown to users, keyed by BCP-47 language tag",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the product, up to 55 characters",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the product, up to 200 characters",
+							Required:            true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"purchase_options": schema.MapNestedAttribute{
+				MarkdownDescription: "The ways users can get the product, keyed by purchase option ID. Removing a purchase option deletes it, along with its offers",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The state of the purchase option, one of: " + strings.Join(basePlanStates, ", ") + ". Defaults to `active`. Activated purchase options cannot return to `draft`",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("active"),
+						},
+						"buy": schema.SingleNestedAttribute{
+							MarkdownDescription: "Sell the product to keep",
+							Attributes: map[string]schema.Attribute{
+								"legacy_compatible": schema.BoolAttribute{
+									MarkdownDescription: "Whether the purchase option is offered to versions of the Play Billing Library which only support in-app products. At most one purchase option of a product can be legacy compatible. Defaults to `false`",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+								"multi_quantity": schema.BoolAttribute{
+									MarkdownDescription: "Whether users can buy more than one of the product at once. Defaults to `false`",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+							},
+							Optional: true,
+						},
+						"rent": schema.SingleNestedAttribute{
+							MarkdownDescription: "Rent the product for a period of time",
+							Attributes: map[string]schema.Attribute{
+								"rental_period": schema.StringAttribute{
+									MarkdownDescription: "The ISO 8601 duration of the rental from purchase, for example `P7D`",
+									Required:            true,
+								},
+								"expiration_period": schema.StringAttribute{
+									MarkdownDescription: "The ISO 8601 duration users have after they start using the product before the rental ends, for example `PT48H`",
+									Optional:            true,
+								},
+							},
+							Optional: true,
+						},
+						"regional_configs": schema.MapNestedAttribute{
+							MarkdownDescription: "The price and availability of the purchase option, keyed by region code, for example `US`",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"price":        moneyAttribute("The price in the region's currency", true),
+									"availability": availabilityAttribute("Whether the purchase option can be bought in the region", purchaseOptionAvailabilities),
+								},
+							},
+							Required: true,
+						},
+						"other_regions": schema.SingleNestedAttribute{
+							MarkdownDescription: "The price and availability of the purchase option in regions Google Play adds in future",
+							Attributes: map[string]schema.Attribute{
+								"usd_price":    moneyAttribute("The price in US dollars", true),
+								"eur_price":    moneyAttribute("The price in euros", true),
+								"availability": availabilityAttribute("Whether the purchase option can be bought in new regions", regionAvailabilities),
+							},
+							Optional: true,
+						},
+						"eea_withdrawal_right_type": schema.StringAttribute{
+							MarkdownDescription: "How the purchase option is classified for the right of withdrawal in the EEA, one of: " + strings.Join(eeaWithdrawalRightTypes, ", "),
+							Optional:            true,
+						},
+						"offer_tags": offerTagsAttribute("Tags returned to the app with the purchase option's details"),
+						"offers": schema.MapNestedAttribute{
+							MarkdownDescription: "Discounted offers on the purchase option, keyed by offer ID. Removing an offer deletes it",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"state": schema.StringAttribute{
+										MarkdownDescription: "The state of the offer, one of: " + strings.Join(basePlanStates, ", ") + ". Defaults to `active`. Activated offers cannot return to `draft`",
+										Optional:            true,
+										Computed:            true,
+										Default:             stringdefault.StaticString("active"),
+									},
+									"start_time": schema.StringAttribute{
+										MarkdownDescription: "When the offer becomes available, as an RFC 3339 timestamp, for example `2025-11-28T00:00:00Z`",
+										Optional:            true,
+									},
+									"end_time": schema.StringAttribute{
+										MarkdownDescription: "When the offer stops being available, as an RFC 3339 timestamp",
+										Optional:            true,
+									},
+									"redemption_limit": schema.Int64Attribute{
+										MarkdownDescription: "The number of times each user can redeem the offer, between 1 and 50. Unlimited when unset",
+										Optional:            true,
+									},
+									"regional_configs": schema.MapNestedAttribute{
+										MarkdownDescription: "The discount in each region where the offer is available, keyed by region code, with exactly one of `absolute_discount`, `relative_discount` or `no_override`. Each region must be in the purchase option's `regional_configs`",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"absolute_discount": moneyAttribute("The amount taken off the purchase option price", false),
+												"relative_discount": schema.Float64Attribute{
+													MarkdownDescription: "The fraction taken off the purchase option price, between 0 and 1, for example `0.25` for 25% off",
+													Optional:            true,
+												},
+												"no_override": schema.BoolAttribute{
+													MarkdownDescription: "Whether the offer uses the purchase option price in the region",
+													Optional:            true,
+												},
+												"availability": availabilityAttribute("Whether the offer is available in the region", regionAvailabilities),
+											},
+										},
+										Required: true,
+									},
+									"offer_tags": offerTagsAttribute("Tags returned to the app with the offer's details"),
+								},
+							},
+							Optional: true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"tax_and_compliance": schema.SingleNestedAttribute{
+				MarkdownDescription: "Tax and legal compliance settings. Only the settings given here are managed",
+				Attributes: map[string]schema.Attribute{
+					"tokenized_digital_asset": schema.BoolAttribute{
+						MarkdownDescription: "Whether the product is a tokenized digital asset",
+						Optional:            true,
+					},
+					"product_tax_category_code": schema.StringAttribute{
+						MarkdownDescription: "The product tax category, which determines the tax rates applied to the product",
+						Optional:            true,
+					},
+					"tax_rates": taxRatesAttribute("product"),
+				},
+				Optional: true,
+			},
+			"restricted_payment_countries": schema.SetAttribute{
+				MarkdownDescription: "Region codes where the product can only be bought with a payment method registered in the same country",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"offer_tags": offerTagsAttribute("Tags returned to the app with the details of every purchase option and offer of the product"),
+		},
+	}
+}
+
+func (r *OneTimeProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OneTimeProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Maps and objects cannot be read until they are known
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data oneTimeProductResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *OneTimeProductResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkRegionsVersion(ctx, r.client, req, resp)
+
+	// Purchase options and offers can move to any state until they are activated
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.Plan.Raw.IsFullyKnown() {
+		return
+	}
+
+	var state, plan oneTimeProductResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, optionID := range slices.Sorted(maps.Keys(plan.PurchaseOptions)) {
+		prior, ok := state.PurchaseOptions[optionID]
+		if !ok {
+			continue
+		}
+		option := plan.PurchaseOptions[optionID]
+		optionPath := path.Root("purchase_options").AtMapKey(optionID)
+		if prior.State.ValueString() != "draft" && option.State.ValueString() == "draft" {
+			resp.Diagnostics.AddAttributeError(
+				optionPath.AtName("state"),
+				"Invalid purchase option state",
+				fmt.Sprintf("Purchase option %s has been activated, so it cannot return to draft. It is currently %s.", optionID, prior.State.ValueString()),
+			)
+		}
+
+		for _, offerID := range slices.Sorted(maps.Keys(option.Offers)) {
+			priorOffer, ok := prior.Offers[offerID]
+			if ok && priorOffer.State.ValueString() != "draft" && option.Offers[offerID].State.ValueString() == "draft" {
+				resp.Diagnostics.AddAttributeError(
+					optionPath.AtName("offers").AtMapKey(offerID).AtName("state"),
+					"Invalid offer state",
+					fmt.Sprintf("Offer %s has been activated, so it cannot return to draft. It is currently %s.", offerID, priorOffer.State.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *OneTimeProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/product_id
+	components := strings.Split(req.ID, "/")
+	if len(components) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/product_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product_id"), components[1])...)
+}
+
+// MoveState moves a googleplay_inapp_product to this resource. Google Play
+// migrates in-app products to one-time products, so the product is read
+// from the monetization API once it has moved.
+func (r *OneTimeProductResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "googleplay_inapp_product" || !strings.HasSuffix(req.SourceProviderAddress, "googleplay") {
+					return
+				}
+
+				var source struct {
+					PackageName string `json:"package_name"`
+					SKU         string `json:"sku"`
+				}
+				if req.SourceRawState == nil || json.Unmarshal(req.SourceRawState.JSON, &source) != nil {
+					resp.Diagnostics.AddError(
+						"Unable to move in-app product",
+						"The state of the googleplay_inapp_product could not be read. Please report this issue to the provider developers.",
+					)
+					return
+				}
+
+				data := oneTimeProductResourceModel{
+					ID:                         types.StringValue(fmt.Sprintf("%s/%s", source.PackageName, source.SKU)),
+					PackageName:                types.StringValue(source.PackageName),
+					ProductID:                  types.StringValue(source.SKU),
+					RegionsVersion:             types.StringNull(),
+					RestrictedPaymentCountries: types.SetNull(types.StringType),
+					OfferTags:                  types.SetNull(types.StringType),
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+func (r *OneTimeProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data oneTimeProductResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Saving a product creates it if it does not exist, so an existing product
+	// is rejected rather than silently taken over
+	_, err := r.client.GetOneTimeProduct(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"One-time product already exists",
+			fmt.Sprintf("The one-time product %s of %s already exists. Import it with terraform import, or with a moved block if it was managed by a googleplay_inapp_product resource, to manage it with Terraform.", data.ProductID.ValueString(), data.PackageName.ValueString()),
+		)
+		return
+	}
+	if !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to fetch one-time product",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.PackageName.ValueString(), data.ProductID.ValueString()))
+	r.saveProduct(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OneTimeProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data oneTimeProductResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	product, err := r.client.GetOneTimeProduct(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch one-time product",
+			err.Error(),
+		)
+		return
+	}
+
+	offers, err := r.client.ListOneTimeProductOffers(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch one-time product offers",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setProduct(ctx, product, offers)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OneTimeProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state oneTimeProductResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.saveProduct(ctx, &data, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OneTimeProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data oneTimeProductResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOneTimeProduct(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete one-time product, got error: %s", err))
+		return
+	}
+}
+
+// saveProduct removes the purchase options and offers which are no longer
+// planned, writes the planned product and offers, moves each of them to its
+// planned state, and records the result.
+func (r *OneTimeProductResource) saveProduct(
+	ctx context.Context,
+	data *oneTimeProductResourceModel,
+	prior *oneTimeProductResourceModel,
+	diagnostics *diag.Diagnostics,
+) {
+	packageName, productID := data.PackageName.ValueString(), data.ProductID.ValueString()
+
+	// Tax rates are saved for every region at once, so the rates of regions
+	// which are not managed are read first to keep them
+	var current map[string]androidpublisher.RegionalTaxRateInfo
+	if prior != nil && data.TaxAndCompliance != nil && data.TaxAndCompliance.TaxRates != nil {
+		existing, err := r.client.GetOneTimeProduct(ctx, packageName, productID)
+		if err != nil {
+			diagnostics.AddError(
+				"Failed to fetch one-time product",
+				err.Error(),
+			)
+			return
+		}
+		if existing.TaxAndComplianceSettings != nil {
+			current = taxRatesByRegion(existing.TaxAndComplianceSettings.RegionalTaxConfigs)
+		}
+	}
+
+	product, diags := data.product(ctx, current)
+	diagnostics.Append(diags...)
+	offers, diags := data.offers(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if prior != nil {
+		removedOptions, removedOffers := prior.removed(*data)
+		if err := r.client.DeleteOneTimeProductOffers(ctx, packageName, productID, removedOffers); err != nil {
+			diagnostics.AddError(
+				"Failed to delete one-time product offers",
+				err.Error(),
+			)
+			return
+		}
+		if err := r.client.DeletePurchaseOptions(ctx, packageName, productID, removedOptions); err != nil {
+			diagnostics.AddError(
+				"Failed to delete purchase options",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	product, err := r.client.SaveOneTimeProduct(ctx, product, data.updateMask(), r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to save one-time product",
+			err.Error(),
+		)
+		return
+	}
+
+	// Drafts must be activated before they can be deactivated, so some
+	// purchase options take two steps to reach their planned state
+	transitions := map[string][]string{}
+	for _, option := range product.PurchaseOptions {
+		if planned, ok := data.PurchaseOptions[option.PurchaseOptionId]; ok {
+			transitions[option.PurchaseOptionId] = basePlanTransitions(basePlanState(option.State), planned.State.ValueString())
+		}
+	}
+	for step := 0; step < 2; step++ {
+		activate, deactivate := []string{}, []string{}
+		for _, optionID := range slices.Sorted(maps.Keys(transitions)) {
+			if steps := transitions[optionID]; step < len(steps) {
+				if steps[step] == "active" {
+					activate = append(activate, optionID)
+				} else {
+					deactivate = append(deactivate, optionID)
+				}
+			}
+		}
+		if len(activate) == 0 && len(deactivate) == 0 {
+			break
+		}
+
+		product, err = r.client.UpdatePurchaseOptionStates(ctx, packageName, productID, activate, deactivate)
+		if err != nil {
+			diagnostics.AddError(
+				"Failed to change purchase option states",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	offers, err = r.client.SaveOneTimeProductOffers(ctx, packageName, productID, offers, r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to save one-time product offers",
+			err.Error(),
+		)
+		return
+	}
+
+	for i, offer := range offers {
+		planned := data.PurchaseOptions[offer.PurchaseOptionId].Offers[offer.OfferId]
+		for _, transition := range basePlanTransitions(basePlanState(offer.State), planned.State.ValueString()) {
+			if transition == "active" {
+				offer, err = r.client.ActivateOneTimeProductOffer(ctx, offer)
+			} else {
+				offer, err = r.client.DeactivateOneTimeProductOffer(ctx, offer)
+			}
+			if err != nil {
+				diagnostics.AddError(
+					fmt.Sprintf("Failed to change one-time product offer %s to %s", offers[i].OfferId, transition),
+					err.Error(),
+				)
+				return
+			}
+		}
+		offers[i] = offer
+	}
+
+	diagnostics.Append(data.setProduct(ctx, product, offers)...)
+}
+
+// removed returns the purchase options of data which are not in planned, and
+// the offers of the remaining purchase options which are not in planned.
+// Offers are deleted along with their purchase option.
+func (data oneTimeProductResourceModel) removed(planned oneTimeProductResourceModel) ([]string, []*androidpublisher.OneTimeProductOffer) {
+	options := []string{}
+	offers := []*androidpublisher.OneTimeProductOffer{}
+	for _, optionID := range slices.Sorted(maps.Keys(data.PurchaseOptions)) {
+		plannedOption, ok := planned.PurchaseOptions[optionID]
+		if !ok {
+			options = append(options, optionID)
+			continue
+		}
+		for _, offerID := range slices.Sorted(maps.Keys(data.PurchaseOptions[optionID].Offers)) {
+			if _, ok := plannedOption.Offers[offerID]; !ok {
+				offers = append(offers, &androidpublisher.OneTimeProductOffer{PurchaseOptionId: optionID, OfferId: offerID})
+			}
+		}
+	}
+	return options, offers
+}
+
+// validate returns an error for each part of the product which Google Play
+// would reject.
+func (data oneTimeProductResourceModel) validate() diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if len(data.Listings) == 0 {
+		diagnostics.AddAttributeError(
+			path.Root("listings"),
+			"Missing listing",
+			"A one-time product must have a listing in at least one language.",
+		)
+	}
+	for _, language := range slices.Sorted(maps.Keys(data.Listings)) {
+		listing := data.Listings[language]
+		if length := len([]rune(listing.Title.ValueString())); length > 55 {
+			diagnostics.AddAttributeError(
+				path.Root("listings").AtMapKey(language).AtName("title"),
+				"Title too long",
+				fmt.Sprintf("The %s title is %d characters, but at most 55 are allowed.", language, length),
+			)
+		}
+		if length := len([]rune(listing.Description.ValueString())); length > 200 {
+			diagnostics.AddAttributeError(
+				path.Root("listings").AtMapKey(language).AtName("description"),
+				"Description too long",
+				fmt.Sprintf("The %s description is %d characters, but at most 200 are allowed.", language, length),
+			)
+		}
+	}
+
+	if len(data.PurchaseOptions) == 0 {
+		diagnostics.AddAttributeError(
+			path.Root("purchase_options"),
+			"Missing purchase option",
+			"A one-time product must have at least one purchase option.",
+		)
+	}
+
+	legacyCompatible := []string{}
+	for _, optionID := range slices.Sorted(maps.Keys(data.PurchaseOptions)) {
+		option := data.PurchaseOptions[optionID]
+		optionPath := path.Root("purchase_options").AtMapKey(optionID)
+
+		if (option.Buy == nil) == (option.Rent == nil) {
+			diagnostics.AddAttributeError(
+				optionPath,
+				"Invalid purchase option",
+				fmt.Sprintf("Purchase option %s must set exactly one of buy or rent.", optionID),
+			)
+		}
+		if option.Buy != nil && option.Buy.LegacyCompatible.ValueBool() {
+			legacyCompatible = append(legacyCompatible, optionID)
+		}
+
+		diagnostics.Append(validateOneOf(optionPath.AtName("state"), "state", option.State, basePlanStates)...)
+		diagnostics.Append(validateOneOf(optionPath.AtName("eea_withdrawal_right_type"), "withdrawal right type", option.EEAWithdrawalRightType, eeaWithdrawalRightTypes)...)
+		for _, region := range slices.Sorted(maps.Keys(option.RegionalConfigs)) {
+			diagnostics.Append(validateOneOf(
+				optionPath.AtName("regional_configs").AtMapKey(region).AtName("availability"),
+				"availability", option.RegionalConfigs[region].Availability, purchaseOptionAvailabilities,
+			)...)
+		}
+		if option.OtherRegions != nil {
+			diagnostics.Append(validateOneOf(
+				optionPath.AtName("other_regions").AtName("availability"),
+				"availability", option.OtherRegions.Availability, regionAvailabilities,
+			)...)
+		}
+
+		for _, offerID := range slices.Sorted(maps.Keys(option.Offers)) {
+			diagnostics.Append(option.Offers[offerID].validate(optionPath.AtName("offers").AtMapKey(offerID), option)...)
+		}
+	}
+
+	if len(legacyCompatible) > 1 {
+		diagnostics.AddAttributeError(
+			path.Root("purchase_options"),
+			"Too many legacy compatible purchase options",
+			fmt.Sprintf("At most one purchase option can be legacy compatible, but %s are.", strings.Join(legacyCompatible, " and ")),
+		)
+	}
+
+	return diagnostics
+}
+
+func (m oneTimeOfferModel) validate(at path.Path, option purchaseOptionModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	diagnostics.Append(validateOneOf(at.AtName("state"), "state", m.State, basePlanStates)...)
+
+	for _, name := range []string{"start_time", "end_time"} {
+		value := m.StartTime
+		if name == "end_time" {
+			value = m.EndTime
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); !value.IsNull() && err != nil {
+			diagnostics.AddAttributeError(
+				at.AtName(name),
+				"Invalid timestamp",
+				fmt.Sprintf("'%s' is not an RFC 3339 timestamp, such as 2025-11-28T00:00:00Z.", value.ValueString()),
+			)
+		}
+	}
+
+	if limit := m.RedemptionLimit.ValueInt64(); !m.RedemptionLimit.IsNull() && (limit < 1 || limit > 50) {
+		diagnostics.AddAttributeError(
+			at.AtName("redemption_limit"),
+			"Invalid redemption limit",
+			fmt.Sprintf("The redemption limit must be between 1 and 50, got: %d.", limit),
+		)
+	}
+
+	if len(m.RegionalConfigs) == 0 {
+		diagnostics.AddAttributeError(
+			at.AtName("regional_configs"),
+			"Missing region",
+			"An offer must be available in at least one region.",
+		)
+	}
+	for _, region := range slices.Sorted(maps.Keys(m.RegionalConfigs)) {
+		config := m.RegionalConfigs[region]
+		configPath := at.AtName("regional_configs").AtMapKey(region)
+
+		if _, ok := option.RegionalConfigs[region]; !ok {
+			diagnostics.AddAttributeError(
+				configPath,
+				"Region not in purchase option",
+				fmt.Sprintf("The offer is available in %s, but its purchase option is not.", region),
+			)
+		}
+
+		discounts := 0
+		for _, set := range []bool{config.AbsoluteDiscount != nil, !config.RelativeDiscount.IsNull(), config.NoOverride.ValueBool()} {
+			if set {
+				discounts++
+			}
+		}
+		if discounts != 1 {
+			diagnostics.AddAttributeError(
+				configPath,
+				"Invalid offer discount",
+				fmt.Sprintf("The offer in %s must set exactly one of absolute_discount, relative_discount or no_override.", region),
+			)
+		}
+		if discount := config.RelativeDiscount.ValueFloat64(); !config.RelativeDiscount.IsNull() && (discount <= 0 || discount >= 1) {
+			diagnostics.AddAttributeError(
+				configPath.AtName("relative_discount"),
+				"Invalid relative discount",
+				fmt.Sprintf("The relative discount must be between 0 and 1, got: %g.", discount),
+			)
+		}
+
+		diagnostics.Append(validateOneOf(configPath.AtName("availability"), "availability", config.Availability, regionAvailabilities)...)
+	}
+
+	return diagnostics
+}
+
+// validateOneOf returns an error if value is set to something other than one
+// of values.
+func validateOneOf(at path.Path, name string, value types.String, values []string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	if !value.IsNull() && !value.IsUnknown() && !slices.Contains(values, value.ValueString()) {
+		diagnostics.AddAttributeError(
+			at,
+			fmt.Sprintf("Invalid %s", name),
+			fmt.Sprintf("'%s' is not a valid %s, expected one of: %s.", value.ValueString(), name, strings.Join(values, ", ")),
+		)
+	}
+	return diagnostics
+}
+
+// product builds the API representation of the planned product, without its
+// offers. Tax rates are merged into currentRates, the rates of every region.
+func (data oneTimeProductResourceModel) product(
+	ctx context.Context,
+	currentRates map[string]androidpublisher.RegionalTaxRateInfo,
+) (*androidpublisher.OneTimeProduct, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	product := &androidpublisher.OneTimeProduct{
+		PackageName: data.PackageName.ValueString(),
+		ProductId:   data.ProductID.ValueString(),
+		OfferTags:   offerTags(ctx, data.OfferTags, &diagnostics),
+	}
+
+	for _, language := range slices.Sorted(maps.Keys(data.Listings)) {
+		listing := data.Listings[language]
+		product.Listings = append(product.Listings, &androidpublisher.OneTimeProductListing{
+			LanguageCode: language,
+			Title:        listing.Title.ValueString(),
+			Description:  listing.Description.ValueString(),
+		})
+	}
+
+	if !data.RestrictedPaymentCountries.IsNull() {
+		product.RestrictedPaymentCountries = &androidpublisher.RestrictedPaymentCountries{}
+		diagnostics.Append(data.RestrictedPaymentCountries.ElementsAs(ctx, &product.RestrictedPaymentCountries.RegionCodes, false)...)
+	}
+
+	if tax := data.TaxAndCompliance; tax != nil {
+		settings := &androidpublisher.OneTimeProductTaxAndComplianceSettings{
+			ProductTaxCategoryCode: tax.ProductTaxCategoryCode.ValueString(),
+		}
+		if !tax.TokenizedDigitalAsset.IsNull() {
+			settings.IsTokenizedDigitalAsset = tax.TokenizedDigitalAsset.ValueBool()
+			settings.ForceSendFields = []string{"IsTokenizedDigitalAsset"}
+		}
+		if tax.TaxRates != nil {
+			rates := mergeTaxRates(currentRates, tax.TaxRates)
+			for _, region := range slices.Sorted(maps.Keys(rates)) {
+				rate := rates[region]
+				settings.RegionalTaxConfigs = append(settings.RegionalTaxConfigs, &androidpublisher.RegionalTaxConfig{
+					RegionCode:                         region,
+					EligibleForStreamingServiceTaxRate: rate.EligibleForStreamingServiceTaxRate,
+					StreamingTaxType:                   rate.StreamingTaxType,
+					TaxTier:                            rate.TaxTier,
+				})
+			}
+		}
+		product.TaxAndComplianceSettings = settings
+	}
+
+	for _, optionID := range slices.Sorted(maps.Keys(data.PurchaseOptions)) {
+		product.PurchaseOptions = append(product.PurchaseOptions, data.PurchaseOptions[optionID].purchaseOption(ctx, optionID, &diagnostics))
+	}
+
+	return product, diagnostics
+}
+
+// updateMask lists the fields of the product which are configured, so that
+// settings which are not managed keep the values set in Play Console.
+func (data oneTimeProductResourceModel) updateMask() []string {
+	mask := []string{"listings", "offerTags", "purchaseOptions"}
+	if !data.RestrictedPaymentCountries.IsNull() {
+		mask = append(mask, "restrictedPaymentCountries")
+	}
+	if tax := data.TaxAndCompliance; tax != nil {
+		if !tax.TokenizedDigitalAsset.IsNull() {
+			mask = append(mask, "taxAndComplianceSettings.isTokenizedDigitalAsset")
+		}
+		if !tax.ProductTaxCategoryCode.IsNull() {
+			mask = append(mask, "taxAndComplianceSettings.productTaxCategoryCode")
+		}
+		if tax.TaxRates != nil {
+			mask = append(mask, "taxAndComplianceSettings.regionalTaxConfigs")
+		}
+	}
+	return mask
+}
+
+// taxRatesByRegion returns the tax settings of each region in configs.
+func taxRatesByRegion(configs []*androidpublisher.RegionalTaxConfig) map[string]androidpublisher.RegionalTaxRateInfo {
+	rates := map[string]androidpublisher.RegionalTaxRateInfo{}
+	for _, config := range configs {
+		rates[config.RegionCode] = androidpublisher.RegionalTaxRateInfo{
+			EligibleForStreamingServiceTaxRate: config.EligibleForStreamingServiceTaxRate,
+			StreamingTaxType:                   config.StreamingTaxType,
+			TaxTier:                            config.TaxTier,
+		}
+	}
+	return rates
+}
+
+func (m purchaseOptionModel) purchaseOption(ctx context.Context, optionID string, diagnostics *diag.Diagnostics) *androidpublisher.OneTimeProductPurchaseOption {
+	option := &androidpublisher.OneTimeProductPurchaseOption{
+		PurchaseOptionId: optionID,
+		OfferTags:        offerTags(ctx, m.OfferTags, diagnostics),
+	}
+
+	if buy := m.Buy; buy != nil {
+		option.BuyOption = &androidpublisher.OneTimeProductBuyPurchaseOption{
+			LegacyCompatible:     buy.LegacyCompatible.ValueBool(),
+			MultiQuantityEnabled: buy.MultiQuantity.ValueBool(),
+		}
+	}
+	if rent := m.Rent; rent != nil {
+		option.RentOption = &androidpublisher.OneTimeProductRentPurchaseOption{
+			RentalPeriod:     rent.RentalPeriod.ValueString(),
+			ExpirationPeriod: rent.ExpirationPeriod.ValueString(),
+		}
+	}
+
+	for _, region := range slices.Sorted(maps.Keys(m.RegionalConfigs)) {
+		config := m.RegionalConfigs[region]
+		option.RegionalPricingAndAvailabilityConfigs = append(option.RegionalPricingAndAvailabilityConfigs, &androidpublisher.OneTimeProductPurchaseOptionRegionalPricingAndAvailabilityConfig{
+			RegionCode:   region,
+			Price:        config.Price.money(),
+			Availability: config.Availability.ValueString(),
+		})
+	}
+	if other := m.OtherRegions; other != nil {
+		option.NewRegionsConfig = &androidpublisher.OneTimeProductPurchaseOptionNewRegionsConfig{
+			UsdPrice:     other.USDPrice.money(),
+			EurPrice:     other.EURPrice.money(),
+			Availability: other.Availability.ValueString(),
+		}
+	}
+
+	if !m.EEAWithdrawalRightType.IsNull() {
+		option.TaxAndComplianceSettings = &androidpublisher.PurchaseOptionTaxAndComplianceSettings{
+			WithdrawalRightType: m.EEAWithdrawalRightType.ValueString(),
+		}
+	}
+
+	return option
+}
+
+// offers builds the API representation of the planned offers of every
+// purchase option.
+func (data oneTimeProductResourceModel) offers(ctx context.Context) ([]*androidpublisher.OneTimeProductOffer, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	offers := []*androidpublisher.OneTimeProductOffer{}
+	for _, optionID := range slices.Sorted(maps.Keys(data.PurchaseOptions)) {
+		option := data.PurchaseOptions[optionID]
+		for _, offerID := range slices.Sorted(maps.Keys(option.Offers)) {
+			model := option.Offers[offerID]
+			offer := &androidpublisher.OneTimeProductOffer{
+				PackageName:      data.PackageName.ValueString(),
+				ProductId:        data.ProductID.ValueString(),
+				PurchaseOptionId: optionID,
+				OfferId:          offerID,
+				OfferTags:        offerTags(ctx, model.OfferTags, &diagnostics),
+				DiscountedOffer: &androidpublisher.OneTimeProductDiscountedOffer{
+					StartTime:       model.StartTime.ValueString(),
+					EndTime:         model.EndTime.ValueString(),
+					RedemptionLimit: model.RedemptionLimit.ValueInt64(),
+				},
+			}
+			for _, region := range slices.Sorted(maps.Keys(model.RegionalConfigs)) {
+				config := model.RegionalConfigs[region]
+				regional := &androidpublisher.OneTimeProductOfferRegionalPricingAndAvailabilityConfig{
+					RegionCode:       region,
+					RelativeDiscount: config.RelativeDiscount.ValueFloat64(),
+					Availability:     config.Availability.ValueString(),
+				}
+				if config.AbsoluteDiscount != nil {
+					regional.AbsoluteDiscount = config.AbsoluteDiscount.money()
+				}
+				if config.NoOverride.ValueBool() {
+					regional.NoOverride = &androidpublisher.OneTimeProductOfferNoPriceOverrideOptions{}
+				}
+				offer.RegionalPricingAndAvailabilityConfigs = append(offer.RegionalPricingAndAvailabilityConfigs, regional)
+			}
+			offers = append(offers, offer)
+		}
+	}
+
+	return offers, diagnostics
+}
+
+// setProduct records product and its offers. Optional settings which are not
+// managed by the resource are left unset, but every purchase option and offer
+// is recorded.
+func (data *oneTimeProductResourceModel) setProduct(
+	ctx context.Context,
+	product *androidpublisher.OneTimeProduct,
+	offers []*androidpublisher.OneTimeProductOffer,
+) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	listings := map[string]oneTimeProductListingModel{}
+	for _, listing := range product.Listings {
+		listings[listing.LanguageCode] = oneTimeProductListingModel{
+			Title:       types.StringValue(listing.Title),
+			Description: types.StringValue(listing.Description),
+		}
+	}
+	data.Listings = listings
+
+	data.OfferTags = offerTagsValue(ctx, data.OfferTags, product.OfferTags, &diagnostics)
+
+	regionCodes := []string{}
+	if product.RestrictedPaymentCountries != nil {
+		regionCodes = product.RestrictedPaymentCountries.RegionCodes
+	}
+	if !data.RestrictedPaymentCountries.IsNull() || len(regionCodes) > 0 {
+		data.RestrictedPaymentCountries = stringSetValue(ctx, regionCodes, &diagnostics)
+	}
+
+	if tax := data.TaxAndCompliance; tax != nil {
+		settings := product.TaxAndComplianceSettings
+		if settings == nil {
+			settings = &androidpublisher.OneTimeProductTaxAndComplianceSettings{}
+		}
+		if !tax.TokenizedDigitalAsset.IsNull() {
+			tax.TokenizedDigitalAsset = types.BoolValue(settings.IsTokenizedDigitalAsset)
+		}
+		if !tax.ProductTaxCategoryCode.IsNull() {
+			tax.ProductTaxCategoryCode = types.StringValue(settings.ProductTaxCategoryCode)
+		}
+		tax.TaxRates = updateTaxRates(tax.TaxRates, taxRatesByRegion(settings.RegionalTaxConfigs))
+	}
+
+	options := map[string]purchaseOptionModel{}
+	for _, option := range product.PurchaseOptions {
+		optionOffers := []*androidpublisher.OneTimeProductOffer{}
+		for _, offer := range offers {
+			if offer.PurchaseOptionId == option.PurchaseOptionId {
+				optionOffers = append(optionOffers, offer)
+			}
+		}
+		prior := data.PurchaseOptions[option.PurchaseOptionId]
+		options[option.PurchaseOptionId] = prior.update(ctx, option, optionOffers, &diagnostics)
+	}
+	data.PurchaseOptions = options
+
+	return diagnostics
+}
+
+// update returns option and its offers as recorded by the resource.
+func (m purchaseOptionModel) update(
+	ctx context.Context,
+	option *androidpublisher.OneTimeProductPurchaseOption,
+	offers []*androidpublisher.OneTimeProductOffer,
+	diagnostics *diag.Diagnostics,
+) purchaseOptionModel {
+	updated := purchaseOptionModel{
+		State:                  types.StringValue(basePlanState(option.State)),
+		RegionalConfigs:        map[string]purchaseOptionRegionModel{},
+		EEAWithdrawalRightType: types.StringNull(),
+		OfferTags:              offerTagsValue(ctx, m.OfferTags, option.OfferTags, diagnostics),
+	}
+
+	if buy := option.BuyOption; buy != nil {
+		updated.Buy = &buyPurchaseOptionModel{
+			LegacyCompatible: types.BoolValue(buy.LegacyCompatible),
+			MultiQuantity:    types.BoolValue(buy.MultiQuantityEnabled),
+		}
+	}
+	if rent := option.RentOption; rent != nil {
+		updated.Rent = &rentPurchaseOptionModel{
+			RentalPeriod:     types.StringValue(rent.RentalPeriod),
+			ExpirationPeriod: optionalString(rent.ExpirationPeriod),
+		}
+	}
+
+	for _, config := range option.RegionalPricingAndAvailabilityConfigs {
+		region := purchaseOptionRegionModel{Availability: types.StringValue(config.Availability)}
+		if config.Price != nil {
+			region.Price = moneyValue(config.Price)
+		}
+		updated.RegionalConfigs[config.RegionCode] = region
+	}
+	if other := option.NewRegionsConfig; other != nil && other.UsdPrice != nil && other.EurPrice != nil {
+		updated.OtherRegions = &purchaseOptionOtherRegionsModel{
+			USDPrice:     moneyValue(other.UsdPrice),
+			EURPrice:     moneyValue(other.EurPrice),
+			Availability: types.StringValue(other.Availability),
+		}
+	}
+
+	if settings := option.TaxAndComplianceSettings; settings != nil {
+		updated.EEAWithdrawalRightType = optionalString(settings.WithdrawalRightType)
+	}
+
+	if len(offers) > 0 || m.Offers != nil {
+		updated.Offers = map[string]oneTimeOfferModel{}
+	}
+	for _, offer := range offers {
+		prior := m.Offers[offer.OfferId]
+		updated.Offers[offer.OfferId] = prior.update(ctx, offer, diagnostics)
+	}
+
+	return updated
+}
+
+// update returns offer as recorded by the resource. Offers without a price
+// override are only recorded as such if m sets no_override.
+func (m oneTimeOfferModel) update(ctx context.Context, offer *androidpublisher.OneTimeProductOffer, diagnostics *diag.Diagnostics) oneTimeOfferModel {
+	updated := oneTimeOfferModel{
+		State:           types.StringValue(basePlanState(offer.State)),
+		StartTime:       types.StringNull(),
+		EndTime:         types.StringNull(),
+		RedemptionLimit: types.Int64Null(),
+		RegionalConfigs: map[string]oneTimeOfferRegionModel{},
+		OfferTags:       offerTagsValue(ctx, m.OfferTags, offer.OfferTags, diagnostics),
+	}
+
+	if discounted := offer.DiscountedOffer; discounted != nil {
+		updated.StartTime = optionalString(discounted.StartTime)
+		updated.EndTime = optionalString(discounted.EndTime)
+		if discounted.RedemptionLimit != 0 {
+			updated.RedemptionLimit = types.Int64Value(discounted.RedemptionLimit)
+		}
+	}
+
+	for _, config := range offer.RegionalPricingAndAvailabilityConfigs {
+		region := oneTimeOfferRegionModel{
+			AbsoluteDiscount: moneyPointer(config.AbsoluteDiscount),
+			RelativeDiscount: optionalFloat64(config.RelativeDiscount),
+			NoOverride:       types.BoolNull(),
+			Availability:     types.StringValue(config.Availability),
+		}
+		if config.NoOverride != nil {
+			region.NoOverride = types.BoolValue(true)
+		} else if !m.RegionalConfigs[config.RegionCode].NoOverride.IsNull() {
+			region.NoOverride = types.BoolValue(false)
+		}
+		updated.RegionalConfigs[config.RegionCode] = region
+	}
+
+	return updated
+}
+
+// offerTags returns the tags in a set of offer tags.
+func offerTags(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []*androidpublisher.OfferTag {
+	tags := []string{}
+	diagnostics.Append(set.ElementsAs(ctx, &tags, false)...)
+
+	offerTags := []*androidpublisher.OfferTag{}
+	for _, tag := range tags {
+		offerTags = append(offerTags, &androidpublisher.OfferTag{Tag: tag})
+	}
+	return offerTags
+}
+
+// offerTagsValue returns tags as a set, or null if there are none and the
+// prior set was null.
+func offerTagsValue(ctx context.Context, prior types.Set, tags []*androidpublisher.OfferTag, diagnostics *diag.Diagnostics) types.Set {
+	values := offerTagNames(tags)
+	if prior.IsNull() && len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return stringSetValue(ctx, values, diagnostics)
+}
+
+func offerTagNames(tags []*androidpublisher.OfferTag) []string {
+	names := []string{}
+	for _, tag := range tags {
+		names = append(names, tag.Tag)
+	}
+	return names
+}
@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testOneTimeProduct() oneTimeProductResourceModel {
+	return oneTimeProductResourceModel{
+		ID:             types.StringValue("com.example.app/coins"),
+		PackageName:    types.StringValue("com.example.app"),
+		ProductID:      types.StringValue("coins"),
+		RegionsVersion: types.StringNull(),
+		Listings: map[string]oneTimeProductListingModel{
+			"en-GB": {Title: types.StringValue("100 coins"), Description: types.StringValue("A bag of coins")},
+		},
+		PurchaseOptions: map[string]purchaseOptionModel{
+			"buy": {
+				State: types.StringValue("active"),
+				Buy: &buyPurchaseOptionModel{
+					LegacyCompatible: types.BoolValue(true),
+					MultiQuantity:    types.BoolValue(false),
+				},
+				RegionalConfigs: map[string]purchaseOptionRegionModel{
+					"GB": {Price: testMoney(1, 990000000, "GBP"), Availability: types.StringValue("AVAILABLE")},
+					"US": {Price: testMoney(1, 990000000, "USD"), Availability: types.StringValue("AVAILABLE")},
+				},
+				EEAWithdrawalRightType: types.StringNull(),
+				OfferTags:              types.SetNull(types.StringType),
+				Offers: map[string]oneTimeOfferModel{
+					"sale": {
+						State:           types.StringValue("active"),
+						StartTime:       types.StringValue("2025-11-28T00:00:00Z"),
+						EndTime:         types.StringNull(),
+						RedemptionLimit: types.Int64Value(1),
+						RegionalConfigs: map[string]oneTimeOfferRegionModel{
+							"GB": {
+								RelativeDiscount: types.Float64Value(0.25),
+								NoOverride:       types.BoolNull(),
+								Availability:     types.StringValue("AVAILABLE"),
+							},
+							"US": {
+								RelativeDiscount: types.Float64Null(),
+								NoOverride:       types.BoolValue(true),
+								Availability:     types.StringValue("AVAILABLE"),
+							},
+						},
+						OfferTags: types.SetNull(types.StringType),
+					},
+				},
+			},
+		},
+		RestrictedPaymentCountries: types.SetNull(types.StringType),
+		OfferTags:                  types.SetNull(types.StringType),
+	}
+}
+
+func TestOneTimeProductValidate(t *testing.T) {
+	assert.Empty(t, testOneTimeProduct().validate())
+
+	product := testOneTimeProduct()
+	option := product.PurchaseOptions["buy"]
+	option.Rent = &rentPurchaseOptionModel{RentalPeriod: types.StringValue("P7D"), ExpirationPeriod: types.StringNull()}
+	option.Offers["sale"].RegionalConfigs["FR"] = oneTimeOfferRegionModel{
+		RelativeDiscount: types.Float64Value(1),
+		NoOverride:       types.BoolValue(true),
+		Availability:     types.StringValue("SOMETIMES"),
+	}
+	product.PurchaseOptions["buy"] = option
+	product.PurchaseOptions["legacy"] = purchaseOptionModel{
+		State:                  types.StringValue("live"),
+		Buy:                    &buyPurchaseOptionModel{LegacyCompatible: types.BoolValue(true)},
+		EEAWithdrawalRightType: types.StringNull(),
+	}
+	assert.Equal(t, []string{
+		"Purchase option buy must set exactly one of buy or rent.",
+		"The offer is available in FR, but its purchase option is not.",
+		"The offer in FR must set exactly one of absolute_discount, relative_discount or no_override.",
+		"The relative discount must be between 0 and 1, got: 1.",
+		"'SOMETIMES' is not a valid availability, expected one of: AVAILABLE, NO_LONGER_AVAILABLE.",
+		"'live' is not a valid state, expected one of: draft, active, inactive.",
+		"At most one purchase option can be legacy compatible, but buy and legacy are.",
+	}, diagnosticDetails(product.validate()))
+}
+
+func TestOneTimeOfferValidate(t *testing.T) {
+	product := testOneTimeProduct()
+	option := product.PurchaseOptions["buy"]
+	offer := option.Offers["sale"]
+	offer.StartTime = types.StringValue("28 November")
+	offer.RedemptionLimit = types.Int64Value(51)
+	assert.Equal(t, []string{
+		"'28 November' is not an RFC 3339 timestamp, such as 2025-11-28T00:00:00Z.",
+		"The redemption limit must be between 1 and 50, got: 51.",
+	}, diagnosticDetails(offer.validate(path.Root("offer"), option)))
+}
+
+func TestOneTimeProductRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	product, diags := testOneTimeProduct().product(ctx, nil)
+	assert.False(t, diags.HasError())
+	assert.True(t, product.PurchaseOptions[0].BuyOption.LegacyCompatible)
+	assert.Equal(t, "GB", product.PurchaseOptions[0].RegionalPricingAndAvailabilityConfigs[0].RegionCode)
+	assert.Nil(t, product.RestrictedPaymentCountries)
+	assert.Nil(t, product.TaxAndComplianceSettings)
+
+	offers, diags := testOneTimeProduct().offers(ctx)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0.25, offers[0].RegionalPricingAndAvailabilityConfigs[0].RelativeDiscount)
+	assert.NotNil(t, offers[0].RegionalPricingAndAvailabilityConfigs[1].NoOverride)
+
+	product.PurchaseOptions[0].State = "ACTIVE"
+	offers[0].State = "ACTIVE"
+	model := testOneTimeProduct()
+	assert.False(t, model.setProduct(ctx, product, offers).HasError())
+	assert.Equal(t, testOneTimeProduct(), model)
+}
+
+func TestOneTimeProductRemoved(t *testing.T) {
+	planned := testOneTimeProduct()
+	planned.PurchaseOptions["buy"] = purchaseOptionModel{}
+
+	options, offers := testOneTimeProduct().removed(planned)
+	assert.Empty(t, options)
+	assert.Equal(t, []*androidpublisher.OneTimeProductOffer{{PurchaseOptionId: "buy", OfferId: "sale"}}, offers)
+
+	options, offers = testOneTimeProduct().removed(oneTimeProductResourceModel{})
+	assert.Equal(t, []string{"buy"}, options)
+	assert.Empty(t, offers)
+}
+
+func TestOneTimeProductMoveState(t *testing.T) {
+	ctx := context.Background()
+	r := &OneTimeProductResource{}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	mover := r.MoveState(ctx)[0]
+
+	move := func(sourceTypeName string) *resource.MoveStateResponse {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResponse.Schema,
+				Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, resource.MoveStateRequest{
+			SourceTypeName:        sourceTypeName,
+			SourceProviderAddress: "registry.terraform.io/oliver-binns/googleplay",
+			SourceRawState: &tfprotov6.RawState{
+				JSON: []byte(`{"id": "com.example.app/coins_100", "package_name": "com.example.app", "sku": "coins_100"}`),
+			},
+		}, resp)
+		return resp
+	}
+
+	resp := move("googleplay_inapp_product")
+	assert.False(t, resp.Diagnostics.HasError())
+	var data oneTimeProductResourceModel
+	assert.False(t, resp.TargetState.Get(ctx, &data).HasError())
+	assert.Equal(t, "com.example.app/coins_100", data.ID.ValueString())
+	assert.Equal(t, "coins_100", data.ProductID.ValueString())
+
+	resp = move("googleplay_subscription")
+	assert.True(t, resp.TargetState.Raw.IsNull())
+}
+
+// testOneTimeProductServer serves a product which exists if existing is set,
+// with the tax settings in current, and saves purchase options and offers in
+// the state given by the request or as drafts.
+func testOneTimeProductServer(t *testing.T, existing bool, current string) (*GooglePlayClient, *[]string, *string, *map[string]interface{}) {
+	var mask string
+	var patched map[string]interface{}
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && !existing:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "not found"}}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(current))
+		case r.Method == http.MethodPatch:
+			mask = r.URL.Query().Get("updateMask")
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &patched))
+			for _, option := range patched["purchaseOptions"].([]interface{}) {
+				option.(map[string]interface{})["state"] = "ACTIVE"
+			}
+			body, _ = json.Marshal(patched)
+			_, _ = w.Write(body)
+		case strings.HasSuffix(r.URL.Path, ":batchUpdate"):
+			_, _ = w.Write([]byte(`{"oneTimeProductOffers": [{"packageName": "com.example.app", "productId": "coins", "purchaseOptionId": "buy", "offerId": "sale", "state": "DRAFT"}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": {"code": 400, "message": "offer has ended"}}`))
+		}
+	})
+	return client, requests, &mask, &patched
+}
+
+func TestOneTimeProductCreateRejectsExistingProduct(t *testing.T) {
+	ctx := context.Background()
+	client, requests, _, _ := testOneTimeProductServer(t, true, `{"productId": "coins"}`)
+
+	r := &OneTimeProductResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	data := testOneTimeProduct()
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResponse.Schema}}
+	assert.False(t, req.Plan.Set(ctx, &data).HasError())
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	r.Create(ctx, req, resp)
+
+	assert.Equal(t, "One-time product already exists", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "terraform import")
+	assert.Equal(t, []string{"GET /oneTimeProducts/coins"}, *requests)
+}
+
+func TestOneTimeProductCreateOnlySendsConfiguredSettings(t *testing.T) {
+	ctx := context.Background()
+	client, requests, mask, patched := testOneTimeProductServer(t, false, "")
+
+	r := &OneTimeProductResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	data := testOneTimeProduct()
+	data.PurchaseOptions["buy"] = purchaseOptionModel{
+		State:                  types.StringValue("active"),
+		Buy:                    data.PurchaseOptions["buy"].Buy,
+		RegionalConfigs:        data.PurchaseOptions["buy"].RegionalConfigs,
+		EEAWithdrawalRightType: types.StringNull(),
+		OfferTags:              types.SetNull(types.StringType),
+	}
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResponse.Schema}}
+	assert.False(t, req.Plan.Set(ctx, &data).HasError())
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	r.Create(ctx, req, resp)
+	assert.Empty(t, diagnosticDetails(resp.Diagnostics))
+
+	assert.Equal(t, "listings,offerTags,purchaseOptions", *mask)
+	assert.NotContains(t, *patched, "taxAndComplianceSettings")
+	assert.NotContains(t, *patched, "restrictedPaymentCountries")
+	assert.Equal(t, []string{
+		"GET /oneTimeProducts/coins",
+		"PATCH /onetimeproducts/coins",
+	}, *requests)
+
+	var state oneTimeProductResourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "com.example.app/coins", state.ID.ValueString())
+	assert.Equal(t, "active", state.PurchaseOptions["buy"].State.ValueString())
+}
+
+func TestOneTimeProductUpdateKeepsUnmanagedTaxSettings(t *testing.T) {
+	ctx := context.Background()
+	client, requests, mask, patched := testOneTimeProductServer(t, true, `{
+		"productId": "coins",
+		"taxAndComplianceSettings": {
+			"productTaxCategoryCode": "digital_content",
+			"regionalTaxConfigs": [
+				{"regionCode": "CA", "taxTier": "TAX_TIER_NEWS_1"},
+				{"regionCode": "US", "taxTier": "TAX_TIER_NEWS_5"}
+			]
+		}
+	}`)
+
+	r := &OneTimeProductResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	state := testOneTimeProduct()
+	data := testOneTimeProduct()
+	data.TaxAndCompliance = &oneTimeProductTaxModel{
+		TokenizedDigitalAsset:  types.BoolValue(false),
+		ProductTaxCategoryCode: types.StringNull(),
+		TaxRates: map[string]taxRateModel{
+			"US": {
+				EligibleForStreamingServiceTaxRate: types.BoolNull(),
+				StreamingTaxType:                   types.StringValue("STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL"),
+				TaxTier:                            types.StringNull(),
+			},
+		},
+	}
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResponse.Schema},
+		State: tfsdk.State{Schema: schemaResponse.Schema},
+	}
+	assert.False(t, req.Plan.Set(ctx, &data).HasError())
+	assert.False(t, req.State.Set(ctx, &state).HasError())
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	r.Update(ctx, req, resp)
+
+	assert.Equal(t, "listings,offerTags,purchaseOptions,taxAndComplianceSettings.isTokenizedDigitalAsset,taxAndComplianceSettings.regionalTaxConfigs", *mask)
+	assert.Equal(t, map[string]interface{}{
+		"isTokenizedDigitalAsset": false,
+		"regionalTaxConfigs": []interface{}{
+			map[string]interface{}{"regionCode": "CA", "taxTier": "TAX_TIER_NEWS_1"},
+			map[string]interface{}{
+				"regionCode":       "US",
+				"streamingTaxType": "STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL",
+				"taxTier":          "TAX_TIER_NEWS_5",
+			},
+		},
+	}, (*patched)["taxAndComplianceSettings"])
+
+	// The offer is saved as a draft and fails to activate
+	assert.Equal(t, "Failed to change one-time product offer sale to active", resp.Diagnostics.Errors()[0].Summary())
+	assert.Equal(t, []string{
+		"GET /oneTimeProducts/coins",
+		"PATCH /onetimeproducts/coins",
+		"POST /oneTimeProducts/coins/purchaseOptions/-/offers:batchUpdate",
+		"POST /oneTimeProducts/coins/purchaseOptions/buy/offers/sale:activate",
+	}, *requests)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseMoneyFunction{}
+
+func NewParseMoneyFunction() function.Function {
+	return &ParseMoneyFunction{}
+}
+
+type ParseMoneyFunction struct{}
+
+func (f *ParseMoneyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_money"
+}
+
+func (f *ParseMoneyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an amount of money",
+		MarkdownDescription: `Parses an amount and currency, such as ` + "`9.99 USD`" + `, into ` + "`currency`" + `, ` + "`units`" + ` and
+		` + "`nanos`" + `, which matches a subscription base plan ` + "`price`" + `, and ` + "`price_micros`" + `, which matches an in-app
+		product price`,
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "amount",
+				MarkdownDescription: "The amount and its ISO 4217 currency code, for example `9.99 USD`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: moneyObjectType.AttrTypes,
+		},
+	}
+}
+
+func (f *ParseMoneyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var amount string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &amount))
+	if resp.Error != nil {
+		return
+	}
+
+	money, err := parseMoney(amount)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValueFrom(ctx, moneyObjectType.AttrTypes, moneyObjectValue(money))
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
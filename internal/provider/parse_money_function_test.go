@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoneyFunction(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("9.99 usd")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(moneyObjectType.AttrTypes))}
+	(&ParseMoneyFunction{}).Run(context.Background(), req, &resp)
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.ObjectValueMust(moneyObjectType.AttrTypes, map[string]attr.Value{
+		"currency":     types.StringValue("USD"),
+		"units":        types.Int64Value(9),
+		"nanos":        types.Int64Value(990000000),
+		"price_micros": types.Int64Value(9990000),
+	}), resp.Result.Value())
+}
+
+func TestParseMoneyFunctionRejectsInvalidAmount(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("$9.99")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(moneyObjectType.AttrTypes))}
+	(&ParseMoneyFunction{}).Run(context.Background(), req, &resp)
+
+	assert.Equal(t, function.NewArgumentFuncError(0, "expected an amount and currency, such as '9.99 USD', got: '$9.99'"), resp.Error)
+}
+
+func TestParseMoneyFunctionRejectsNonDecimalAmounts(t *testing.T) {
+	for amount, message := range map[string]string{
+		"0x10 USD":                 "'0x10' is not a decimal amount",
+		"0b1 USD":                  "'0b1' is not a decimal amount",
+		"99999999999999999999 USD": "'99999999999999999999' is too large",
+	} {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(amount)}),
+		}
+		resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(moneyObjectType.AttrTypes))}
+		(&ParseMoneyFunction{}).Run(context.Background(), req, &resp)
+
+		assert.Equal(t, function.NewArgumentFuncError(0, message), resp.Error, amount)
+	}
+}
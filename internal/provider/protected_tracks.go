@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultProtectedTracks are protected when the provider does not set
+// protected_tracks.
+var defaultProtectedTracks = []string{"production"}
+
+// IsProtectedTrack reports whether releases on track need
+// allow_production_changes before they can be changed.
+func (c *GooglePlayClient) IsProtectedTrack(track string) bool {
+	return slices.Contains(c.protectedTracks, track)
+}
+
+// guardProtectedTrack stops releases on protected tracks from changing unless
+// the resource allows it, and warns about the change when it does.
+func (r *TrackReleaseResource) guardProtectedTrack(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The provider is not configured when validating configuration
+	if r.client == nil {
+		return
+	}
+
+	var plan trackReleaseResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Track.IsUnknown() || !r.client.IsProtectedTrack(plan.Track.ValueString()) {
+		return
+	}
+
+	var state *trackReleaseResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &trackReleaseResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state != nil && !releaseChanged(*state, plan) {
+		return
+	}
+
+	change := describeReleaseChange(state, plan)
+	if !plan.AllowProductionChanges.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_production_changes"),
+			"Protected track",
+			fmt.Sprintf(
+				"The %s track is protected by the provider's protected_tracks setting. "+
+					"Set allow_production_changes = true on this resource to apply this change:\n\n%s",
+				plan.Track.ValueString(), change,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("Changing a release on the protected %s track", plan.Track.ValueString()),
+		change,
+	)
+}
+
+// releaseChanged reports whether applying plan would change the release
+// users receive.
+func releaseChanged(state trackReleaseResourceModel, plan trackReleaseResourceModel) bool {
+	return !state.VersionCodes.Equal(plan.VersionCodes) ||
+		!state.Status.Equal(plan.Status) ||
+		!state.UserFraction.Equal(plan.UserFraction) ||
+		!state.ReleaseNotes.Equal(plan.ReleaseNotes) ||
+		!state.Name.Equal(plan.Name)
+}
+
+// describeReleaseChange summarises the version codes, status and user fraction
+// of a planned release. state is nil when the release is being created.
+func describeReleaseChange(state *trackReleaseResourceModel, plan trackReleaseResourceModel) string {
+	lines := []string{
+		"Version codes: " + formatVersionCodes(plan.VersionCodes),
+	}
+	if state == nil {
+		lines = append(lines,
+			"Status: "+formatValue(plan.Status.IsUnknown(), plan.Status.ValueString()),
+			"User fraction: "+formatUserFraction(plan.Status, plan.UserFraction),
+		)
+	} else {
+		if !state.VersionCodes.Equal(plan.VersionCodes) {
+			lines[0] = fmt.Sprintf("Version codes: %s -> %s", formatVersionCodes(state.VersionCodes), formatVersionCodes(plan.VersionCodes))
+		}
+		lines = append(lines,
+			fmt.Sprintf("Status: %s -> %s", state.Status.ValueString(), formatValue(plan.Status.IsUnknown(), plan.Status.ValueString())),
+			fmt.Sprintf("User fraction: %s -> %s", formatUserFraction(state.Status, state.UserFraction), formatUserFraction(plan.Status, plan.UserFraction)),
+		)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatVersionCodes(versionCodes types.Set) string {
+	if versionCodes.IsUnknown() {
+		return "(known after apply)"
+	}
+	known := []int64{}
+	unknown := 0
+	for _, element := range versionCodes.Elements() {
+		versionCode, ok := element.(types.Int64)
+		if !ok || versionCode.IsUnknown() {
+			unknown++
+			continue
+		}
+		known = append(known, versionCode.ValueInt64())
+	}
+	slices.Sort(known)
+
+	codes := []string{}
+	for _, versionCode := range known {
+		codes = append(codes, strconv.FormatInt(versionCode, 10))
+	}
+	for range unknown {
+		codes = append(codes, "(known after apply)")
+	}
+	return strings.Join(codes, ", ")
+}
+
+func formatUserFraction(status types.String, fraction types.Float64) string {
+	if fraction.IsNull() {
+		// Completed releases reach every user, and drafts reach none
+		if ReleaseStatus(status.ValueString()) == ReleaseCompleted {
+			return "1 (all users)"
+		}
+		return "none"
+	}
+	return formatValue(fraction.IsUnknown(), strconv.FormatFloat(fraction.ValueFloat64(), 'g', -1, 64))
+}
+
+func formatValue(unknown bool, value string) string {
+	if unknown {
+		return "(known after apply)"
+	}
+	return value
+}
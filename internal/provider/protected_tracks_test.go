@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRelease(status string, fraction *float64, versionCodes ...int64) trackReleaseResourceModel {
+	elements := []attr.Value{}
+	for _, versionCode := range versionCodes {
+		elements = append(elements, types.Int64Value(versionCode))
+	}
+	return trackReleaseResourceModel{
+		VersionCodes: types.SetValueMust(types.Int64Type, elements),
+		Status:       types.StringValue(status),
+		UserFraction: types.Float64PointerValue(fraction),
+		ReleaseNotes: types.MapNull(types.StringType),
+		Name:         types.StringValue("1.0"),
+	}
+}
+
+func TestIsProtectedTrack(t *testing.T) {
+	client := &GooglePlayClient{protectedTracks: defaultProtectedTracks}
+	assert.True(t, client.IsProtectedTrack("production"))
+	assert.False(t, client.IsProtectedTrack("beta"))
+
+	client = &GooglePlayClient{protectedTracks: []string{}}
+	assert.False(t, client.IsProtectedTrack("production"))
+}
+
+func TestReleaseChanged(t *testing.T) {
+	state := testRelease("inProgress", fraction(0.1), 10)
+
+	assert.False(t, releaseChanged(state, testRelease("inProgress", fraction(0.1), 10)))
+	assert.True(t, releaseChanged(state, testRelease("inProgress", fraction(0.2), 10)))
+	assert.True(t, releaseChanged(state, testRelease("completed", nil, 10)))
+	assert.True(t, releaseChanged(state, testRelease("inProgress", fraction(0.1), 11)))
+
+	// Allowing production changes does not change the release itself
+	allowed := testRelease("inProgress", fraction(0.1), 10)
+	allowed.AllowProductionChanges = types.BoolValue(true)
+	assert.False(t, releaseChanged(state, allowed))
+}
+
+func TestDescribeReleaseChange(t *testing.T) {
+	plan := testRelease("inProgress", fraction(0.05), 12, 11)
+	assert.Equal(t,
+		"Version codes: 11, 12\nStatus: inProgress\nUser fraction: 0.05",
+		describeReleaseChange(nil, plan),
+	)
+
+	state := testRelease("inProgress", fraction(0.05), 11, 12)
+	assert.Equal(t,
+		"Version codes: 11, 12\nStatus: inProgress -> completed\nUser fraction: 0.05 -> 1 (all users)",
+		describeReleaseChange(&state, testRelease("completed", nil, 11, 12)),
+	)
+
+	assert.Equal(t,
+		"Version codes: 11, 12 -> 13\nStatus: inProgress -> draft\nUser fraction: 0.05 -> none",
+		describeReleaseChange(&state, testRelease("draft", nil, 13)),
+	)
+}
+
+func TestFormatVersionCodes(t *testing.T) {
+	assert.Equal(t, "(known after apply)", formatVersionCodes(types.SetUnknown(types.Int64Type)))
+
+	versionCodes := types.SetValueMust(types.Int64Type, []attr.Value{
+		types.Int64Unknown(),
+		types.Int64Value(2),
+	})
+	assert.Equal(t, "2, (known after apply)", formatVersionCodes(versionCodes))
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+// regionsVersionPattern matches regions versions, such as 2022/02. Versions
+// in this format sort in the order they were released.
+var regionsVersionPattern = regexp.MustCompile(`^\d{4}/\d{2}$`)
+
+// latestRegionsVersion remembers the latest regions version for the life of
+// the provider process, so it is only fetched once per plan.
+type latestRegionsVersion struct {
+	once    sync.Once
+	version string
+	err     error
+}
+
+// regionsVersionAttribute lets a resource override the provider's
+// regions_version setting for the prices it saves.
+func regionsVersionAttribute(subject string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The version of the available regions to use when saving the " + subject + ", for example `2022/02`. " +
+			"Overrides `regions_version` on the provider",
+		Optional: true,
+	}
+}
+
+// RegionsVersion returns override if it is set, or else the provider's
+// regions version.
+func (c *GooglePlayClient) RegionsVersion(override types.String) string {
+	if !override.IsNull() && !override.IsUnknown() {
+		return override.ValueString()
+	}
+	if c.regionsVersion != "" {
+		return c.regionsVersion
+	}
+	return defaultRegionsVersion
+}
+
+// LatestRegionsVersion returns the newest regions version Google Play
+// reports. Google Play only reports it when converting a price, so a price is
+// converted for the app.
+func (c *GooglePlayClient) LatestRegionsVersion(ctx context.Context, packageName string) (string, error) {
+	c.latestRegionsVersion.once.Do(func() {
+		resp, err := c.service.Monetization.ConvertRegionPrices(packageName, &androidpublisher.ConvertRegionPricesRequest{
+			Price: &androidpublisher.Money{Units: 1, CurrencyCode: "USD"},
+		}).Context(ctx).Do()
+		if err != nil {
+			c.latestRegionsVersion.err = err
+			return
+		}
+		if resp.RegionVersion != nil {
+			c.latestRegionsVersion.version = resp.RegionVersion.Version
+		}
+	})
+	return c.latestRegionsVersion.version, c.latestRegionsVersion.err
+}
+
+// validateRegionsVersion returns an error if version is not in the format
+// YYYY/MM.
+func validateRegionsVersion(at path.Path, version types.String) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	if !version.IsNull() && !version.IsUnknown() && !regionsVersionPattern.MatchString(version.ValueString()) {
+		diagnostics.AddAttributeError(
+			at,
+			"Invalid regions version",
+			fmt.Sprintf("Expected a regions version in the format YYYY/MM, such as %s, got: %s", defaultRegionsVersion, version.ValueString()),
+		)
+	}
+	return diagnostics
+}
+
+// checkRegionsVersion validates the regions_version of a planned resource,
+// and warns if the resource will save its prices with an older version than
+// the latest one. Regions added since then cannot be priced until the version
+// is updated.
+func checkRegionsVersion(ctx context.Context, client *GooglePlayClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var packageName, override types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("package_name"), &packageName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("regions_version"), &override)...)
+	resp.Diagnostics.Append(validateRegionsVersion(path.Root("regions_version"), override)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only warn about resources which will be saved
+	if client == nil || packageName.IsUnknown() || override.IsUnknown() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	latest, err := client.LatestRegionsVersion(ctx, packageName.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "unable to fetch the latest regions version", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	version := client.RegionsVersion(override)
+	if latest != "" && version < latest {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("regions_version"),
+			"Outdated regions version",
+			fmt.Sprintf(
+				"Prices will be saved with regions version %s, but the latest is %s. "+
+					"Regions added since %s cannot be priced or made available until regions_version is updated, on the provider or this resource.",
+				version, latest, version,
+			),
+		)
+	}
+}
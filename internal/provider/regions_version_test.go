@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionsVersion(t *testing.T) {
+	client := &GooglePlayClient{}
+	assert.Equal(t, defaultRegionsVersion, client.RegionsVersion(types.StringNull()))
+
+	client.regionsVersion = "2024/08"
+	assert.Equal(t, "2024/08", client.RegionsVersion(types.StringNull()))
+	assert.Equal(t, "2025/03", client.RegionsVersion(types.StringValue("2025/03")))
+}
+
+func TestLatestRegionsVersionIsFetchedOnce(t *testing.T) {
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"regionVersion": {"version": "2025/03"}}`))
+	})
+
+	for range 2 {
+		latest, err := client.LatestRegionsVersion(context.Background(), "com.example.app")
+		assert.NoError(t, err)
+		assert.Equal(t, "2025/03", latest)
+	}
+	assert.Equal(t, []string{"POST /pricing:convertRegionPrices"}, *requests)
+}
+
+func TestValidateRegionsVersion(t *testing.T) {
+	assert.Empty(t, validateRegionsVersion(path.Root("regions_version"), types.StringValue("2025/03")))
+	assert.Empty(t, validateRegionsVersion(path.Root("regions_version"), types.StringNull()))
+	assert.Equal(t, []string{
+		"Expected a regions version in the format YYYY/MM, such as 2022/02, got: 2025-03",
+	}, diagnosticDetails(validateRegionsVersion(path.Root("regions_version"), types.StringValue("2025-03"))))
+}
+
+func TestCheckRegionsVersionWarnsWhenOutdated(t *testing.T) {
+	ctx := context.Background()
+	client, _ := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"regionVersion": {"version": "2025/03"}}`))
+	})
+
+	schemaResponse := &resource.SchemaResponse{}
+	(&SubscriptionResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	check := func(regionsVersion types.String) diag.Diagnostics {
+		data := testSubscription()
+		data.RegionsVersion = regionsVersion
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{
+				Schema: schemaResponse.Schema,
+				Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			},
+			Plan: tfsdk.Plan{Schema: schemaResponse.Schema},
+		}
+		assert.False(t, req.Plan.Set(ctx, &data).HasError())
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		checkRegionsVersion(ctx, client, req, resp)
+		return resp.Diagnostics
+	}
+
+	assert.Equal(t, []string{
+		"Prices will be saved with regions version 2022/02, but the latest is 2025/03. " +
+			"Regions added since 2022/02 cannot be priced or made available until regions_version is updated, on the provider or this resource.",
+	}, diagnosticDetails(check(types.StringNull())))
+	assert.Empty(t, check(types.StringValue("2025/03")))
+}
@@ -0,0 +1,49 @@
+package provider
+
+import "fmt"
+
+type ReleaseStatus string
+
+const (
+	ReleaseDraft      ReleaseStatus = "draft"
+	ReleaseInProgress ReleaseStatus = "inProgress"
+	ReleaseHalted     ReleaseStatus = "halted"
+	ReleaseCompleted  ReleaseStatus = "completed"
+)
+
+var releaseStatuses = []ReleaseStatus{
+	ReleaseDraft,
+	ReleaseInProgress,
+	ReleaseHalted,
+	ReleaseCompleted,
+}
+
+// HasUserFraction reports whether releases with this status are rolled out to
+// a fraction of users.
+func (status ReleaseStatus) HasUserFraction() bool {
+	return status == ReleaseInProgress || status == ReleaseHalted
+}
+
+// ValidateUserFraction checks that a user fraction is given only when it
+// applies to the status. fraction is nil when no user fraction is set.
+func (status ReleaseStatus) ValidateUserFraction(fraction *float64) error {
+	if fraction == nil {
+		if status == ReleaseInProgress {
+			return fmt.Errorf("user_fraction is required for %s releases", status)
+		}
+		return nil
+	}
+	if !status.HasUserFraction() {
+		return fmt.Errorf(
+			"user_fraction can only be set for %s or %s releases, but the status is %s",
+			ReleaseInProgress, ReleaseHalted, status,
+		)
+	}
+	if *fraction <= 0 || *fraction >= 1 {
+		return fmt.Errorf(
+			"user_fraction must be greater than 0 and less than 1, got %g: use the %s status to release to all users",
+			*fraction, ReleaseCompleted,
+		)
+	}
+	return nil
+}
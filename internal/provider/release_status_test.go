@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fraction(value float64) *float64 {
+	return &value
+}
+
+func TestValidateUserFractionInProgress(t *testing.T) {
+	assert.NoError(t, ReleaseInProgress.ValidateUserFraction(fraction(0.1)))
+}
+
+func TestValidateUserFractionInProgressRequired(t *testing.T) {
+	assert.ErrorContains(
+		t,
+		ReleaseInProgress.ValidateUserFraction(nil),
+		"user_fraction is required for inProgress releases",
+	)
+}
+
+func TestValidateUserFractionOutOfRange(t *testing.T) {
+	assert.Error(t, ReleaseInProgress.ValidateUserFraction(fraction(0)))
+	assert.Error(t, ReleaseInProgress.ValidateUserFraction(fraction(1)))
+	assert.Error(t, ReleaseInProgress.ValidateUserFraction(fraction(1.5)))
+}
+
+func TestValidateUserFractionHalted(t *testing.T) {
+	assert.NoError(t, ReleaseHalted.ValidateUserFraction(fraction(0.2)))
+	assert.NoError(t, ReleaseHalted.ValidateUserFraction(nil))
+}
+
+func TestValidateUserFractionCompleted(t *testing.T) {
+	assert.NoError(t, ReleaseCompleted.ValidateUserFraction(nil))
+	assert.ErrorContains(
+		t,
+		ReleaseCompleted.ValidateUserFraction(fraction(0.5)),
+		"user_fraction can only be set for inProgress or halted releases",
+	)
+}
+
+func TestValidateUserFractionDraft(t *testing.T) {
+	assert.NoError(t, ReleaseDraft.ValidateUserFraction(nil))
+	assert.Error(t, ReleaseDraft.ValidateUserFraction(fraction(0.5)))
+}
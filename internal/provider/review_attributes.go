@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// changesNotSentForReviewAttribute lets a resource override the provider's
+// changes_not_sent_for_review setting for the edits it commits.
+func changesNotSentForReviewAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Commit changes without sending them for review. Overrides `changes_not_sent_for_review` on the provider",
+		Optional:            true,
+	}
+}
+
+// sentForReviewAttribute records whether the last commit made by a resource
+// sent its changes for review.
+func sentForReviewAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether the last change made by this resource was sent for review when it was committed. " +
+			"`false` when the changes were committed with `changes_not_sent_for_review`, or only validated by `validate_only` on the provider",
+		Computed: true,
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditResourcesHaveReviewAttributes(t *testing.T) {
+	resources := []resource.Resource{
+		NewListingImagesResource(),
+		NewTrackReleaseResource(),
+		NewBundleResource(),
+		NewApkResource(),
+		NewDeobfuscationFileResource(),
+		NewTrackResource(),
+		NewTrackTestersResource(),
+	}
+
+	for _, r := range resources {
+		resp := &resource.SchemaResponse{}
+		r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+		assert.Contains(t, resp.Schema.Attributes, "changes_not_sent_for_review")
+		assert.Contains(t, resp.Schema.Attributes, "sent_for_review")
+	}
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &ReviewReplyResource{}
+var _ resource.ResourceWithImportState = &ReviewReplyResource{}
+var _ resource.ResourceWithValidateConfig = &ReviewReplyResource{}
+
+// maxReplyLength is the most characters Google Play allows in a reply.
+const maxReplyLength = 350
+
+func NewReviewReplyResource() resource.Resource {
+	return &ReviewReplyResource{}
+}
+
+type ReviewReplyResource struct {
+	client *GooglePlayClient
+}
+
+type reviewReplyResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	PackageName  types.String `tfsdk:"package_name"`
+	ReviewID     types.String `tfsdk:"review_id"`
+	ReplyText    types.String `tfsdk:"reply_text"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+func (r *ReviewReplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_review_reply"
+}
+
+func (r *ReviewReplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Reply to a user's review of an app. Replies edited in Play Console show up as changes,
+		and the resource is recreated if the reply is removed. Replies cannot be deleted through the API, so destroying this resource only removes it from Terraform state`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the reply, in the format `package_name/review_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"review_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the review to reply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reply_text": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The text of the reply, up to %d characters", maxReplyLength),
+				Required:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "When the reply was last changed, as an RFC 3339 timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ReviewReplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ReviewReplyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var replyText types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("reply_text"), &replyText)...)
+
+	if resp.Diagnostics.HasError() || replyText.IsNull() || replyText.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateReplyText(path.Root("reply_text"), replyText.ValueString())...)
+}
+
+func (r *ReviewReplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/review_id
+	components := strings.Split(req.ID, "/")
+	if len(components) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/review_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("review_id"), components[1])...)
+}
+
+func (r *ReviewReplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data reviewReplyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.ReplyToReview(ctx, data.PackageName.ValueString(), data.ReviewID.ValueString(), data.ReplyText.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reply to review",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.PackageName.ValueString(), data.ReviewID.ValueString()))
+	data.setReply(result)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewReplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data reviewReplyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	review, err := r.client.GetReview(ctx, data.PackageName.ValueString(), data.ReviewID.ValueString())
+	if isNotFound(err) {
+		tflog.Info(ctx, "review has been deleted, removing from state", map[string]interface{}{
+			"review_id": data.ReviewID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch review",
+			err.Error(),
+		)
+		return
+	}
+
+	reply := developerReply(review)
+	if reply == nil {
+		tflog.Info(ctx, "reply has been removed, removing from state", map[string]interface{}{
+			"review_id": data.ReviewID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Replies edited in Play Console replace the text in state, so the
+	// configured text is planned as a change
+	data.ReplyText = types.StringValue(reply.Text)
+	data.LastModified = optionalString(formatTimestamp(reply.LastModified))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewReplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data reviewReplyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.ReplyToReview(ctx, data.PackageName.ValueString(), data.ReviewID.ValueString(), data.ReplyText.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update reply",
+			err.Error(),
+		)
+		return
+	}
+
+	data.setReply(result)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewReplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Replies cannot be deleted through the API, so the reply is only removed
+	// from Terraform state.
+	tflog.Info(ctx, "review replies cannot be deleted from Google Play, removing from state only")
+}
+
+// validateReplyText returns an error if Google Play would reject text as a
+// reply.
+func validateReplyText(at path.Path, text string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	if strings.TrimSpace(text) == "" {
+		diagnostics.AddAttributeError(
+			at,
+			"Empty reply",
+			"reply_text must not be empty.",
+		)
+	}
+	if length := len([]rune(text)); length > maxReplyLength {
+		diagnostics.AddAttributeError(
+			at,
+			"Reply too long",
+			fmt.Sprintf("The reply is %d characters, but at most %d are allowed.", length, maxReplyLength),
+		)
+	}
+	return diagnostics
+}
+
+// setReply records when the reply was saved. The planned text is kept, so any
+// changes Google Play makes to it show up when the reply is next read.
+func (data *reviewReplyResourceModel) setReply(result *androidpublisher.ReviewReplyResult) {
+	data.LastModified = types.StringNull()
+	if result != nil {
+		data.LastModified = optionalString(formatTimestamp(result.LastEdited))
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReplyText(t *testing.T) {
+	at := path.Root("reply_text")
+	assert.Empty(t, validateReplyText(at, "Thanks for the review!"))
+	assert.Empty(t, validateReplyText(at, strings.Repeat("é", maxReplyLength)))
+
+	assert.Equal(t, []string{"reply_text must not be empty."}, diagnosticDetails(validateReplyText(at, "  ")))
+	assert.Equal(t, []string{
+		"The reply is 351 characters, but at most 350 are allowed.",
+	}, diagnosticDetails(validateReplyText(at, strings.Repeat("a", maxReplyLength+1))))
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ datasource.DataSource = &ReviewsDataSource{}
+
+func NewReviewsDataSource() datasource.DataSource {
+	return &ReviewsDataSource{}
+}
+
+type ReviewsDataSource struct {
+	client *GooglePlayClient
+}
+
+type reviewsDataSourceModel struct {
+	PackageName         types.String  `tfsdk:"package_name"`
+	TranslationLanguage types.String  `tfsdk:"translation_language"`
+	StarRatings         []int64       `tfsdk:"star_ratings"`
+	VersionCodes        []int64       `tfsdk:"version_codes"`
+	Devices             []string      `tfsdk:"devices"`
+	Languages           []string      `tfsdk:"languages"`
+	Reviews             []reviewModel `tfsdk:"reviews"`
+}
+
+type reviewModel struct {
+	ReviewID       types.String      `tfsdk:"review_id"`
+	AuthorName     types.String      `tfsdk:"author_name"`
+	StarRating     types.Int64       `tfsdk:"star_rating"`
+	Text           types.String      `tfsdk:"text"`
+	OriginalText   types.String      `tfsdk:"original_text"`
+	Language       types.String      `tfsdk:"language"`
+	Device         types.String      `tfsdk:"device"`
+	VersionCode    types.Int64       `tfsdk:"version_code"`
+	VersionName    types.String      `tfsdk:"version_name"`
+	LastModified   types.String      `tfsdk:"last_modified"`
+	DeveloperReply *reviewReplyModel `tfsdk:"developer_reply"`
+}
+
+type reviewReplyModel struct {
+	Text         types.String `tfsdk:"text"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+func (d *ReviewsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reviews"
+}
+
+func (d *ReviewsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Fetch the reviews of an app which were written or changed in the last week, most recent first.
+		Reviews can be filtered by star rating, app version, device and language`,
+
+		Attributes: map[string]schema.Attribute{
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+			},
+			"translation_language": schema.StringAttribute{
+				MarkdownDescription: "Translate reviews into this language, for example `en`. Defaults to the language each review was written in",
+				Optional:            true,
+			},
+			"star_ratings": schema.SetAttribute{
+				MarkdownDescription: "Only include reviews with one of these star ratings, from 1 to 5",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"version_codes": schema.SetAttribute{
+				MarkdownDescription: "Only include reviews of these versions of the app",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"devices": schema.SetAttribute{
+				MarkdownDescription: "Only include reviews from these devices, given as device codenames such as `oriole`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Only include reviews written in these languages. A language such as `en` also matches regional variants such as `en_GB`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"reviews": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching reviews, most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"review_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the review, used to reply to it",
+							Computed:            true,
+						},
+						"author_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the user who wrote the review",
+							Computed:            true,
+						},
+						"star_rating": schema.Int64Attribute{
+							MarkdownDescription: "The star rating, from 1 to 5",
+							Computed:            true,
+						},
+						"text": schema.StringAttribute{
+							MarkdownDescription: "The text of the review, translated into `translation_language` if it is set",
+							Computed:            true,
+						},
+						"original_text": schema.StringAttribute{
+							MarkdownDescription: "The text of the review before it was translated. Only set when the review was translated",
+							Computed:            true,
+						},
+						"language": schema.StringAttribute{
+							MarkdownDescription: "The language the review was written in, for example `en_GB`",
+							Computed:            true,
+						},
+						"device": schema.StringAttribute{
+							MarkdownDescription: "The codename of the user's device",
+							Computed:            true,
+						},
+						"version_code": schema.Int64Attribute{
+							MarkdownDescription: "The version code of the app the user had installed",
+							Computed:            true,
+						},
+						"version_name": schema.StringAttribute{
+							MarkdownDescription: "The version name of the app the user had installed",
+							Computed:            true,
+						},
+						"last_modified": schema.StringAttribute{
+							MarkdownDescription: "When the review was last changed, as an RFC 3339 timestamp",
+							Computed:            true,
+						},
+						"developer_reply": schema.SingleNestedAttribute{
+							MarkdownDescription: "The developer's reply to the review, if there is one",
+							Attributes: map[string]schema.Attribute{
+								"text": schema.StringAttribute{
+									MarkdownDescription: "The text of the reply",
+									Computed:            true,
+								},
+								"last_modified": schema.StringAttribute{
+									MarkdownDescription: "When the reply was last changed, as an RFC 3339 timestamp",
+									Computed:            true,
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ReviewsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ReviewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data reviewsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reviews, err := d.client.ListReviews(ctx, data.PackageName.ValueString(), data.TranslationLanguage.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch reviews",
+			err.Error(),
+		)
+		return
+	}
+
+	data.Reviews = []reviewModel{}
+	for _, review := range reviews {
+		if comment := userComment(review); comment != nil && data.matches(comment) {
+			data.Reviews = append(data.Reviews, reviewValue(review, comment))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches reports whether a review passes every filter which is set.
+func (data reviewsDataSourceModel) matches(comment *androidpublisher.UserComment) bool {
+	if data.StarRatings != nil && !slices.Contains(data.StarRatings, comment.StarRating) {
+		return false
+	}
+	if data.VersionCodes != nil && !slices.Contains(data.VersionCodes, comment.AppVersionCode) {
+		return false
+	}
+	if data.Devices != nil && !slices.Contains(data.Devices, comment.Device) {
+		return false
+	}
+	if data.Languages != nil && !slices.ContainsFunc(data.Languages, func(language string) bool {
+		return matchesLanguage(comment.ReviewerLanguage, language)
+	}) {
+		return false
+	}
+	return true
+}
+
+// matchesLanguage reports whether a reviewer's language, such as en_GB, is
+// language or one of its regional variants.
+func matchesLanguage(reviewerLanguage string, language string) bool {
+	normalize := func(language string) string {
+		return strings.ToLower(strings.ReplaceAll(language, "-", "_"))
+	}
+	reviewerLanguage, language = normalize(reviewerLanguage), normalize(language)
+	return reviewerLanguage == language || strings.HasPrefix(reviewerLanguage, language+"_")
+}
+
+func reviewValue(review *androidpublisher.Review, comment *androidpublisher.UserComment) reviewModel {
+	model := reviewModel{
+		ReviewID:     types.StringValue(review.ReviewId),
+		AuthorName:   optionalString(review.AuthorName),
+		StarRating:   types.Int64Value(comment.StarRating),
+		Text:         types.StringValue(strings.TrimSpace(comment.Text)),
+		OriginalText: optionalString(strings.TrimSpace(comment.OriginalText)),
+		Language:     optionalString(comment.ReviewerLanguage),
+		Device:       optionalString(comment.Device),
+		VersionCode:  types.Int64Null(),
+		VersionName:  optionalString(comment.AppVersionName),
+		LastModified: optionalString(formatTimestamp(comment.LastModified)),
+	}
+	if comment.AppVersionCode != 0 {
+		model.VersionCode = types.Int64Value(comment.AppVersionCode)
+	}
+	if reply := developerReply(review); reply != nil {
+		model.DeveloperReply = &reviewReplyModel{
+			Text:         types.StringValue(reply.Text),
+			LastModified: optionalString(formatTimestamp(reply.LastModified)),
+		}
+	}
+	return model
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testUserComment() *androidpublisher.UserComment {
+	return &androidpublisher.UserComment{
+		Text:             "\tGreat app, but it crashes on launch",
+		StarRating:       4,
+		ReviewerLanguage: "en_GB",
+		Device:           "oriole",
+		AppVersionCode:   210,
+		AppVersionName:   "2.1",
+		LastModified:     &androidpublisher.Timestamp{Seconds: 1764288000},
+	}
+}
+
+func TestReviewsMatches(t *testing.T) {
+	comment := testUserComment()
+	assert.True(t, reviewsDataSourceModel{}.matches(comment))
+	assert.True(t, reviewsDataSourceModel{
+		StarRatings:  []int64{4, 5},
+		VersionCodes: []int64{210},
+		Devices:      []string{"oriole"},
+		Languages:    []string{"en"},
+	}.matches(comment))
+
+	assert.False(t, reviewsDataSourceModel{StarRatings: []int64{1, 2}}.matches(comment))
+	assert.False(t, reviewsDataSourceModel{VersionCodes: []int64{200}}.matches(comment))
+	assert.False(t, reviewsDataSourceModel{Devices: []string{"panther"}}.matches(comment))
+	assert.False(t, reviewsDataSourceModel{Languages: []string{"en-US", "fr"}}.matches(comment))
+}
+
+func TestMatchesLanguage(t *testing.T) {
+	assert.True(t, matchesLanguage("en_GB", "en"))
+	assert.True(t, matchesLanguage("en_GB", "en-gb"))
+	assert.False(t, matchesLanguage("en_GB", "e"))
+	assert.False(t, matchesLanguage("en", "en_GB"))
+}
+
+func TestReviewValue(t *testing.T) {
+	review := &androidpublisher.Review{
+		ReviewId:   "review-1",
+		AuthorName: "Alex",
+		Comments: []*androidpublisher.Comment{
+			{UserComment: testUserComment()},
+			{DeveloperComment: &androidpublisher.DeveloperComment{Text: "Fixed in 2.2!"}},
+		},
+	}
+
+	assert.Equal(t, reviewModel{
+		ReviewID:     types.StringValue("review-1"),
+		AuthorName:   types.StringValue("Alex"),
+		StarRating:   types.Int64Value(4),
+		Text:         types.StringValue("Great app, but it crashes on launch"),
+		OriginalText: types.StringNull(),
+		Language:     types.StringValue("en_GB"),
+		Device:       types.StringValue("oriole"),
+		VersionCode:  types.Int64Value(210),
+		VersionName:  types.StringValue("2.1"),
+		LastModified: types.StringValue("2025-11-28T00:00:00Z"),
+		DeveloperReply: &reviewReplyModel{
+			Text:         types.StringValue("Fixed in 2.2!"),
+			LastModified: types.StringNull(),
+		},
+	}, reviewValue(review, userComment(review)))
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+)
+
+// rolloutSchedule increases the user fraction of a staged rollout in steps,
+// waiting at least minSoakTime between each one.
+type rolloutSchedule struct {
+	steps       []float64
+	minSoakTime time.Duration
+
+	// haltOnCrashRate halts the rollout while crashRate is above it. Both are
+	// nil when the schedule has no halt condition.
+	haltOnCrashRate *float64
+	crashRate       *float64
+}
+
+func (s rolloutSchedule) Validate() error {
+	if len(s.steps) == 0 {
+		return fmt.Errorf("steps must contain at least one user fraction")
+	}
+	for i, step := range s.steps {
+		if step <= 0 || step > 1 {
+			return fmt.Errorf("step %d is %g, but steps must be greater than 0 and at most 1", i, step)
+		}
+		if i > 0 && step <= s.steps[i-1] {
+			return fmt.Errorf("step %d is %g, but steps must increase, following %g", i, step, s.steps[i-1])
+		}
+	}
+	if s.minSoakTime < 0 {
+		return fmt.Errorf("min_soak_time must not be negative")
+	}
+	return nil
+}
+
+// Halted reports whether the crash rate has crossed the halt threshold.
+func (s rolloutSchedule) Halted() bool {
+	if s.haltOnCrashRate == nil || s.crashRate == nil {
+		return false
+	}
+	return *s.crashRate > *s.haltOnCrashRate
+}
+
+// NextStep returns the step the rollout should be at, given it reached
+// current at startedAt. The rollout advances at most one step at a time.
+func (s rolloutSchedule) NextStep(current int, startedAt time.Time, now time.Time) int {
+	if s.Halted() || current >= len(s.steps)-1 {
+		return current
+	}
+	if now.Sub(startedAt) < s.minSoakTime {
+		return current
+	}
+	return current + 1
+}
+
+// Release returns the status and user fraction of the release at step.
+// The user fraction is nil once the release reaches every user.
+func (s rolloutSchedule) Release(step int) (ReleaseStatus, *float64) {
+	fraction := s.steps[step]
+	if fraction >= 1 {
+		return ReleaseCompleted, nil
+	}
+	if s.Halted() {
+		return ReleaseHalted, &fraction
+	}
+	return ReleaseInProgress, &fraction
+}
+
+// StartingStep returns the step at which to take over a release currently
+// rolled out to fraction, so that a schedule never reduces a rollout.
+// fraction is nil when the release is not being rolled out.
+func (s rolloutSchedule) StartingStep(fraction *float64) int {
+	if fraction == nil {
+		return 0
+	}
+	for i, step := range s.steps {
+		if step >= *fraction {
+			return i
+		}
+	}
+	return len(s.steps) - 1
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRolloutSchedule = rolloutSchedule{
+	steps:       []float64{0.01, 0.05, 0.2, 1},
+	minSoakTime: 24 * time.Hour,
+}
+
+func TestRolloutScheduleValidate(t *testing.T) {
+	assert.NoError(t, testRolloutSchedule.Validate())
+}
+
+func TestRolloutScheduleValidateEmpty(t *testing.T) {
+	assert.Error(t, rolloutSchedule{}.Validate())
+}
+
+func TestRolloutScheduleValidateOutOfRange(t *testing.T) {
+	assert.Error(t, rolloutSchedule{steps: []float64{0}}.Validate())
+	assert.Error(t, rolloutSchedule{steps: []float64{0.5, 1.5}}.Validate())
+}
+
+func TestRolloutScheduleValidateMustIncrease(t *testing.T) {
+	assert.ErrorContains(
+		t,
+		rolloutSchedule{steps: []float64{0.2, 0.1}}.Validate(),
+		"steps must increase",
+	)
+}
+
+func TestRolloutScheduleNextStepWaitsForSoak(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 1, testRolloutSchedule.NextStep(1, startedAt, startedAt.Add(23*time.Hour)))
+	assert.Equal(t, 2, testRolloutSchedule.NextStep(1, startedAt, startedAt.Add(24*time.Hour)))
+}
+
+func TestRolloutScheduleNextStepAdvancesOneStepAtATime(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 1, testRolloutSchedule.NextStep(0, startedAt, startedAt.Add(30*24*time.Hour)))
+}
+
+func TestRolloutScheduleNextStepStopsAtLastStep(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 3, testRolloutSchedule.NextStep(3, startedAt, startedAt.Add(48*time.Hour)))
+}
+
+func TestRolloutScheduleHalted(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := testRolloutSchedule
+	schedule.haltOnCrashRate = fraction(0.01)
+	schedule.crashRate = fraction(0.02)
+
+	assert.True(t, schedule.Halted())
+	assert.Equal(t, 1, schedule.NextStep(1, startedAt, startedAt.Add(48*time.Hour)))
+
+	status, userFraction := schedule.Release(1)
+	assert.Equal(t, ReleaseHalted, status)
+	assert.Equal(t, fraction(0.05), userFraction)
+}
+
+func TestRolloutScheduleNotHaltedBelowThreshold(t *testing.T) {
+	schedule := testRolloutSchedule
+	schedule.haltOnCrashRate = fraction(0.01)
+	schedule.crashRate = fraction(0.005)
+
+	assert.False(t, schedule.Halted())
+}
+
+func TestRolloutScheduleRelease(t *testing.T) {
+	status, userFraction := testRolloutSchedule.Release(2)
+	assert.Equal(t, ReleaseInProgress, status)
+	assert.Equal(t, fraction(0.2), userFraction)
+
+	status, userFraction = testRolloutSchedule.Release(3)
+	assert.Equal(t, ReleaseCompleted, status)
+	assert.Nil(t, userFraction)
+}
+
+func TestRolloutScheduleStartingStep(t *testing.T) {
+	assert.Equal(t, 0, testRolloutSchedule.StartingStep(nil))
+	assert.Equal(t, 1, testRolloutSchedule.StartingStep(fraction(0.05)))
+	assert.Equal(t, 2, testRolloutSchedule.StartingStep(fraction(0.1)))
+}
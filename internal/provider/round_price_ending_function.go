@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &RoundPriceEndingFunction{}
+
+func NewRoundPriceEndingFunction() function.Function {
+	return &RoundPriceEndingFunction{}
+}
+
+type RoundPriceEndingFunction struct{}
+
+func (f *RoundPriceEndingFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "round_price_ending"
+}
+
+func (f *RoundPriceEndingFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Round an amount of money to a price ending in .99",
+		MarkdownDescription: `Rounds money to the nearest amount ending in ` + "`.99`" + `, or to a whole number for currencies without
+		a minor unit, such as ` + "`JPY`" + `. Use this to tidy prices calculated in Terraform, for example a discount applied to
+		the results of ` + "`convert_region_prices`" + `.
+
+		This is a heuristic, not Google Play's price points: it ignores the region a price is for, and the pricing
+		conventions Google Play uses there. Currencies with three decimal places, such as ` + "`KWD`" + `, are rejected.
+		To get prices which follow Google Play's conventions for each region, use ` + "`convert_region_prices`" + `.`,
+
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "money",
+				MarkdownDescription: "The money to round, with `currency`, `units` and `nanos` attributes",
+				AttributeTypes:      moneyParameterAttributes,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: moneyObjectType.AttrTypes,
+		},
+	}
+}
+
+func (f *RoundPriceEndingFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var money moneyParameterModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &money))
+	if resp.Error != nil {
+		return
+	}
+
+	if money.Units < 0 || money.Nanos < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "money must not be negative")
+		return
+	}
+
+	rounded, err := roundPriceEnding(money.money())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValueFrom(ctx, moneyObjectType.AttrTypes, moneyObjectValue(rounded))
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
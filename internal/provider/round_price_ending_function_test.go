@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundPriceEndingFunction(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.ObjectValueMust(moneyParameterAttributes, map[string]attr.Value{
+				"currency": types.StringValue("EUR"),
+				"units":    types.Int64Value(8),
+				"nanos":    types.Int64Value(491000000),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(moneyObjectType.AttrTypes))}
+	(&RoundPriceEndingFunction{}).Run(context.Background(), req, &resp)
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.ObjectValueMust(moneyObjectType.AttrTypes, map[string]attr.Value{
+		"currency":     types.StringValue("EUR"),
+		"units":        types.Int64Value(8),
+		"nanos":        types.Int64Value(990000000),
+		"price_micros": types.Int64Value(8990000),
+	}), resp.Result.Value())
+}
+
+func TestRoundPriceEndingFunctionThreeDecimalCurrency(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.ObjectValueMust(moneyParameterAttributes, map[string]attr.Value{
+				"currency": types.StringValue("KWD"),
+				"units":    types.Int64Value(1),
+				"nanos":    types.Int64Value(450000000),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(moneyObjectType.AttrTypes))}
+	(&RoundPriceEndingFunction{}).Run(context.Background(), req, &resp)
+
+	assert.ErrorContains(t, resp.Error, "KWD has three decimal places")
+}
@@ -0,0 +1,674 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &SubscriptionBasePlanResource{}
+var _ resource.ResourceWithImportState = &SubscriptionBasePlanResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionBasePlanResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionBasePlanResource{}
+
+// basePlanStates are the lifecycle states of a base plan. New base plans are
+// drafts, and cannot return to draft once they have been activated.
+var basePlanStates = []string{"draft", "active", "inactive"}
+
+// resubscribeStates control whether users can resubscribe from the Play Store
+// after cancelling.
+var resubscribeStates = []string{"RESUBSCRIBE_STATE_ACTIVE", "RESUBSCRIBE_STATE_INACTIVE"}
+
+func NewSubscriptionBasePlanResource() resource.Resource {
+	return &SubscriptionBasePlanResource{}
+}
+
+type SubscriptionBasePlanResource struct {
+	client *GooglePlayClient
+}
+
+type basePlanResourceModel struct {
+	ID              types.String                           `tfsdk:"id"`
+	PackageName     types.String                           `tfsdk:"package_name"`
+	ProductID       types.String                           `tfsdk:"product_id"`
+	BasePlanID      types.String                           `tfsdk:"base_plan_id"`
+	State           types.String                           `tfsdk:"state"`
+	RegionsVersion  types.String                           `tfsdk:"regions_version"`
+	AutoRenewing    *autoRenewingModel                     `tfsdk:"auto_renewing"`
+	Prepaid         *prepaidModel                          `tfsdk:"prepaid"`
+	RegionalConfigs map[string]regionalBasePlanConfigModel `tfsdk:"regional_configs"`
+	OtherRegions    *otherRegionsModel                     `tfsdk:"other_regions"`
+	OfferTags       types.Set                              `tfsdk:"offer_tags"`
+}
+
+type autoRenewingModel struct {
+	BillingPeriod       types.String `tfsdk:"billing_period"`
+	GracePeriod         types.String `tfsdk:"grace_period"`
+	AccountHoldDuration types.String `tfsdk:"account_hold_duration"`
+	ResubscribeState    types.String `tfsdk:"resubscribe_state"`
+}
+
+type prepaidModel struct {
+	BillingPeriod types.String `tfsdk:"billing_period"`
+	TimeExtension types.String `tfsdk:"time_extension"`
+}
+
+type regionalBasePlanConfigModel struct {
+	Price                     moneyModel `tfsdk:"price"`
+	NewSubscriberAvailability types.Bool `tfsdk:"new_subscriber_availability"`
+}
+
+type otherRegionsModel struct {
+	USDPrice                  moneyModel `tfsdk:"usd_price"`
+	EURPrice                  moneyModel `tfsdk:"eur_price"`
+	NewSubscriberAvailability types.Bool `tfsdk:"new_subscriber_availability"`
+}
+
+func (r *SubscriptionBasePlanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_base_plan"
+}
+
+func (r *SubscriptionBasePlanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Manage a base plan of a subscription. Exactly one of ` + "`auto_renewing`" + ` or ` + "`prepaid`" + ` must be set.
+		Activated base plans cannot be deleted, so destroying one deactivates it instead`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the base plan, in the format `package_name/product_id/base_plan_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subscription the base plan belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_plan_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the base plan, unique within the subscription",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the base plan, one of: " + strings.Join(basePlanStates, ", ") + ". Defaults to `active`. Activated base plans cannot return to `draft`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("active"),
+			},
+			"regions_version": regionsVersionAttribute("base plan"),
+			"auto_renewing": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for a base plan which renews automatically at the end of each billing period",
+				Attributes: map[string]schema.Attribute{
+					"billing_period": schema.StringAttribute{
+						MarkdownDescription: "The ISO 8601 duration of each billing period, for example `P1M`. Cannot be changed once the base plan has been activated",
+						Required:            true,
+					},
+					"grace_period": schema.StringAttribute{
+						MarkdownDescription: "The ISO 8601 duration users keep access for after a payment fails, for example `P7D`",
+						Optional:            true,
+					},
+					"account_hold_duration": schema.StringAttribute{
+						MarkdownDescription: "The ISO 8601 duration of the account hold after the grace period, for example `P30D`",
+						Optional:            true,
+					},
+					"resubscribe_state": schema.StringAttribute{
+						MarkdownDescription: "Whether users can resubscribe from the Play Store after cancelling, one of: " + strings.Join(resubscribeStates, ", "),
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"prepaid": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for a prepaid base plan, which users top up manually",
+				Attributes: map[string]schema.Attribute{
+					"billing_period": schema.StringAttribute{
+						MarkdownDescription: "The ISO 8601 duration bought with each top up, for example `P1M`. Cannot be changed once the base plan has been activated",
+						Required:            true,
+					},
+					"time_extension": schema.StringAttribute{
+						MarkdownDescription: "Whether users can top up before their current period ends, `TIME_EXTENSION_ACTIVE` or `TIME_EXTENSION_INACTIVE`",
+						Optional:            true,
+					},
+				},
+				Optional: true,
+			},
+			"regional_configs": schema.MapNestedAttribute{
+				MarkdownDescription: "The price and availability of the base plan, keyed by region code, for example `US`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"price": moneyAttribute("The price of the base plan in the region's currency", true),
+						"new_subscriber_availability": schema.BoolAttribute{
+							MarkdownDescription: "Whether new users in the region can subscribe. Defaults to `true`",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+				},
+				Required: true,
+			},
+			"other_regions": schema.SingleNestedAttribute{
+				MarkdownDescription: "The price and availability of the base plan in regions Google Play adds in future",
+				Attributes: map[string]schema.Attribute{
+					"usd_price": moneyAttribute("The price in US dollars", true),
+					"eur_price": moneyAttribute("The price in euros", true),
+					"new_subscriber_availability": schema.BoolAttribute{
+						MarkdownDescription: "Whether new users in new regions can subscribe. Defaults to `true`",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+				},
+				Optional: true,
+			},
+			"offer_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags returned to the app with the base plan's details",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SubscriptionBasePlanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubscriptionBasePlanResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Maps and objects cannot be read until they are known
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data basePlanResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.AutoRenewing == nil) == (data.Prepaid == nil) {
+		resp.Diagnostics.AddError(
+			"Invalid base plan type",
+			"Exactly one of auto_renewing or prepaid must be set.",
+		)
+	}
+
+	if !data.State.IsNull() && !slices.Contains(basePlanStates, data.State.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid base plan state",
+			fmt.Sprintf("'%s' is not a valid state, expected one of: %s.", data.State.ValueString(), strings.Join(basePlanStates, ", ")),
+		)
+	}
+
+	if data.AutoRenewing != nil && !data.AutoRenewing.ResubscribeState.IsNull() {
+		state := data.AutoRenewing.ResubscribeState.ValueString()
+		if !slices.Contains(resubscribeStates, state) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auto_renewing").AtName("resubscribe_state"),
+				"Invalid resubscribe state",
+				fmt.Sprintf("'%s' is not a valid resubscribe state, expected one of: %s.", state, strings.Join(resubscribeStates, ", ")),
+			)
+		}
+	}
+}
+
+func (r *SubscriptionBasePlanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkRegionsVersion(ctx, r.client, req, resp)
+
+	// Base plans can be changed freely until they are activated
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state basePlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.State.ValueString() == "draft" {
+		return
+	}
+
+	var planned types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("state"), &planned)...)
+	if planned.ValueString() == "draft" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid base plan state",
+			fmt.Sprintf("The base plan has been activated, so it cannot return to draft. It is currently %s.", state.State.ValueString()),
+		)
+		return
+	}
+
+	// A base plan with a new ID can have any type and billing period
+	var packageName, productID, basePlanID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("package_name"), &packageName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("product_id"), &productID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("base_plan_id"), &basePlanID)...)
+	if resp.Diagnostics.HasError() ||
+		!packageName.Equal(state.PackageName) || !productID.Equal(state.ProductID) || !basePlanID.Equal(state.BasePlanID) {
+		return
+	}
+
+	// The type and billing period of activated base plans are fixed. Google
+	// Play does not allow activated base plans to be deleted, so they cannot
+	// be replaced by a base plan with the same ID either.
+	for _, planType := range []string{"auto_renewing", "prepaid"} {
+		var prior, plan types.Object
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(planType), &prior)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(planType), &plan)...)
+		if resp.Diagnostics.HasError() || plan.IsUnknown() {
+			return
+		}
+
+		changed := path.Root(planType)
+		if prior.IsNull() == plan.IsNull() {
+			if plan.IsNull() || prior.Attributes()["billing_period"].Equal(plan.Attributes()["billing_period"]) {
+				continue
+			}
+			changed = changed.AtName("billing_period")
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			changed,
+			"Cannot change an activated base plan",
+			fmt.Sprintf(
+				"Base plan %s has been activated, so its type and billing period cannot be changed. "+
+					"Google Play does not allow activated base plans to be deleted, so it cannot be replaced with the same ID either. "+
+					"Choose a new base_plan_id to create a base plan with the new type or billing period.",
+				state.BasePlanID.ValueString(),
+			),
+		)
+		return
+	}
+}
+
+func (r *SubscriptionBasePlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/product_id/base_plan_id
+	components := strings.Split(req.ID, "/")
+	if len(components) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/product_id/base_plan_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product_id"), components[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("base_plan_id"), components[2])...)
+}
+
+func (r *SubscriptionBasePlanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data basePlanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf(
+		"%s/%s/%s", data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString(),
+	))
+	r.saveBasePlan(ctx, &data, "draft", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionBasePlanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data basePlanResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	basePlan, err := r.client.GetBasePlan(ctx, data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch base plan",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setBasePlan(ctx, basePlan)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionBasePlanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state basePlanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.saveBasePlan(ctx, &data, state.State.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionBasePlanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data basePlanResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName, productID, basePlanID := data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString()
+
+	var err error
+	switch data.State.ValueString() {
+	case "draft":
+		err = r.client.DeleteBasePlan(ctx, packageName, productID, basePlanID)
+	case "active":
+		_, err = r.client.DeactivateBasePlan(ctx, packageName, productID, basePlanID)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete base plan, got error: %s", err))
+		return
+	}
+
+	if data.State.ValueString() != "draft" {
+		resp.Diagnostics.AddWarning(
+			"Base plan left inactive",
+			fmt.Sprintf(
+				"Google Play does not allow activated base plans to be deleted, so %s has been deactivated instead. "+
+					"Existing subscribers keep their subscriptions, but new users cannot subscribe.",
+				basePlanID,
+			),
+		)
+	}
+}
+
+// saveBasePlan writes the planned base plan, moves it from its current state
+// to the planned state, and records the result.
+func (r *SubscriptionBasePlanResource) saveBasePlan(
+	ctx context.Context,
+	data *basePlanResourceModel,
+	current string,
+	diagnostics *diag.Diagnostics,
+) {
+	packageName, productID, basePlanID := data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString()
+
+	basePlan, diags := data.basePlan(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	basePlan, err := r.client.PutBasePlan(ctx, packageName, productID, basePlan, r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to save base plan",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, transition := range basePlanTransitions(current, data.State.ValueString()) {
+		var subscription *androidpublisher.Subscription
+		if transition == "active" {
+			subscription, err = r.client.ActivateBasePlan(ctx, packageName, productID, basePlanID)
+		} else {
+			subscription, err = r.client.DeactivateBasePlan(ctx, packageName, productID, basePlanID)
+		}
+		if err != nil {
+			diagnostics.AddError(
+				fmt.Sprintf("Failed to change base plan to %s", transition),
+				err.Error(),
+			)
+			return
+		}
+		basePlan = findBasePlan(subscription, basePlanID)
+	}
+
+	diagnostics.Append(data.setBasePlan(ctx, basePlan)...)
+}
+
+// basePlanTransitions returns the states a base plan passes through to move
+// from one state to another. Drafts must be activated before they can be
+// deactivated.
+func basePlanTransitions(from string, to string) []string {
+	switch {
+	case from == to:
+		return []string{}
+	case to == "inactive" && from == "draft":
+		return []string{"active", "inactive"}
+	default:
+		return []string{to}
+	}
+}
+
+// basePlanState converts a base plan state returned by the API into the
+// state used by the resource.
+func basePlanState(state string) string {
+	switch state {
+	case "ACTIVE":
+		return "active"
+	case "INACTIVE", "INACTIVE_PUBLISHED":
+		return "inactive"
+	case "DRAFT":
+		return "draft"
+	}
+	return strings.ToLower(state)
+}
+
+// basePlan builds the API representation of the planned base plan.
+func (data basePlanResourceModel) basePlan(ctx context.Context) (*androidpublisher.BasePlan, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	basePlan := &androidpublisher.BasePlan{
+		BasePlanId: data.BasePlanID.ValueString(),
+	}
+
+	if renewing := data.AutoRenewing; renewing != nil {
+		basePlan.AutoRenewingBasePlanType = &androidpublisher.AutoRenewingBasePlanType{
+			BillingPeriodDuration: renewing.BillingPeriod.ValueString(),
+			GracePeriodDuration:   renewing.GracePeriod.ValueString(),
+			AccountHoldDuration:   renewing.AccountHoldDuration.ValueString(),
+			ResubscribeState:      renewing.ResubscribeState.ValueString(),
+		}
+	}
+	if prepaid := data.Prepaid; prepaid != nil {
+		basePlan.PrepaidBasePlanType = &androidpublisher.PrepaidBasePlanType{
+			BillingPeriodDuration: prepaid.BillingPeriod.ValueString(),
+			TimeExtension:         prepaid.TimeExtension.ValueString(),
+		}
+	}
+
+	for _, region := range slices.Sorted(maps.Keys(data.RegionalConfigs)) {
+		config := data.RegionalConfigs[region]
+		basePlan.RegionalConfigs = append(basePlan.RegionalConfigs, &androidpublisher.RegionalBasePlanConfig{
+			RegionCode:                region,
+			Price:                     config.Price.money(),
+			NewSubscriberAvailability: config.NewSubscriberAvailability.ValueBool(),
+			ForceSendFields:           []string{"NewSubscriberAvailability"},
+		})
+	}
+
+	if other := data.OtherRegions; other != nil {
+		basePlan.OtherRegionsConfig = &androidpublisher.OtherRegionsBasePlanConfig{
+			UsdPrice:                  other.USDPrice.money(),
+			EurPrice:                  other.EURPrice.money(),
+			NewSubscriberAvailability: other.NewSubscriberAvailability.ValueBool(),
+			ForceSendFields:           []string{"NewSubscriberAvailability"},
+		}
+	}
+
+	tags := []string{}
+	diagnostics.Append(data.OfferTags.ElementsAs(ctx, &tags, false)...)
+	for _, tag := range tags {
+		basePlan.OfferTags = append(basePlan.OfferTags, &androidpublisher.OfferTag{Tag: tag})
+	}
+
+	return basePlan, diagnostics
+}
+
+// setBasePlan records basePlan. Optional settings which are not managed by
+// the resource are left unset.
+func (data *basePlanResourceModel) setBasePlan(ctx context.Context, basePlan *androidpublisher.BasePlan) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	data.State = types.StringValue(basePlanState(basePlan.State))
+
+	data.AutoRenewing = data.AutoRenewing.update(basePlan.AutoRenewingBasePlanType)
+	data.Prepaid = data.Prepaid.update(basePlan.PrepaidBasePlanType)
+
+	configs := map[string]regionalBasePlanConfigModel{}
+	for _, config := range basePlan.RegionalConfigs {
+		model := regionalBasePlanConfigModel{
+			NewSubscriberAvailability: types.BoolValue(config.NewSubscriberAvailability),
+		}
+		if config.Price != nil {
+			model.Price = moneyValue(config.Price)
+		}
+		configs[config.RegionCode] = model
+	}
+	data.RegionalConfigs = configs
+
+	data.OtherRegions = nil
+	if other := basePlan.OtherRegionsConfig; other != nil {
+		data.OtherRegions = &otherRegionsModel{
+			NewSubscriberAvailability: types.BoolValue(other.NewSubscriberAvailability),
+		}
+		if other.UsdPrice != nil {
+			data.OtherRegions.USDPrice = moneyValue(other.UsdPrice)
+		}
+		if other.EurPrice != nil {
+			data.OtherRegions.EURPrice = moneyValue(other.EurPrice)
+		}
+	}
+
+	tags := []string{}
+	for _, tag := range basePlan.OfferTags {
+		tags = append(tags, tag.Tag)
+	}
+	if !data.OfferTags.IsNull() || len(tags) > 0 {
+		data.OfferTags = stringSetValue(ctx, tags, &diagnostics)
+	}
+
+	return diagnostics
+}
+
+// update returns the settings of renewing which are managed by m. Only the
+// billing period is managed when m is nil, for example after an import.
+func (m *autoRenewingModel) update(renewing *androidpublisher.AutoRenewingBasePlanType) *autoRenewingModel {
+	if renewing == nil {
+		return nil
+	}
+
+	updated := autoRenewingModel{
+		GracePeriod:         types.StringNull(),
+		AccountHoldDuration: types.StringNull(),
+		ResubscribeState:    types.StringNull(),
+	}
+	if m != nil {
+		updated = *m
+	}
+
+	updated.BillingPeriod = types.StringValue(renewing.BillingPeriodDuration)
+	if !updated.GracePeriod.IsNull() {
+		updated.GracePeriod = types.StringValue(renewing.GracePeriodDuration)
+	}
+	if !updated.AccountHoldDuration.IsNull() {
+		updated.AccountHoldDuration = types.StringValue(renewing.AccountHoldDuration)
+	}
+	if !updated.ResubscribeState.IsNull() {
+		updated.ResubscribeState = types.StringValue(renewing.ResubscribeState)
+	}
+	return &updated
+}
+
+// update returns the settings of prepaid which are managed by m.
+func (m *prepaidModel) update(prepaid *androidpublisher.PrepaidBasePlanType) *prepaidModel {
+	if prepaid == nil {
+		return nil
+	}
+
+	updated := prepaidModel{
+		TimeExtension: types.StringNull(),
+	}
+	if m != nil {
+		updated = *m
+	}
+
+	updated.BillingPeriod = types.StringValue(prepaid.BillingPeriodDuration)
+	if !updated.TimeExtension.IsNull() {
+		updated.TimeExtension = types.StringValue(prepaid.TimeExtension)
+	}
+	return &updated
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testMoney(units int64, nanos int64, currency string) moneyModel {
+	return moneyValue(&androidpublisher.Money{Units: units, Nanos: nanos, CurrencyCode: currency})
+}
+
+func testBasePlan() basePlanResourceModel {
+	return basePlanResourceModel{
+		ID:             types.StringValue("com.example.app/premium/monthly"),
+		PackageName:    types.StringValue("com.example.app"),
+		ProductID:      types.StringValue("premium"),
+		BasePlanID:     types.StringValue("monthly"),
+		State:          types.StringValue("active"),
+		RegionsVersion: types.StringNull(),
+		AutoRenewing: &autoRenewingModel{
+			BillingPeriod:       types.StringValue("P1M"),
+			GracePeriod:         types.StringValue("P7D"),
+			AccountHoldDuration: types.StringNull(),
+			ResubscribeState:    types.StringNull(),
+		},
+		RegionalConfigs: map[string]regionalBasePlanConfigModel{
+			"GB": {
+				Price:                     testMoney(4, 990000000, "GBP"),
+				NewSubscriberAvailability: types.BoolValue(true),
+			},
+		},
+		OfferTags: types.SetNull(types.StringType),
+	}
+}
+
+func TestBasePlanTransitions(t *testing.T) {
+	assert.Empty(t, basePlanTransitions("active", "active"))
+	assert.Equal(t, []string{"active"}, basePlanTransitions("draft", "active"))
+	assert.Equal(t, []string{"active", "inactive"}, basePlanTransitions("draft", "inactive"))
+	assert.Equal(t, []string{"inactive"}, basePlanTransitions("active", "inactive"))
+	assert.Equal(t, []string{"active"}, basePlanTransitions("inactive", "active"))
+}
+
+func TestBasePlanState(t *testing.T) {
+	assert.Equal(t, "draft", basePlanState("DRAFT"))
+	assert.Equal(t, "active", basePlanState("ACTIVE"))
+	assert.Equal(t, "inactive", basePlanState("INACTIVE_PUBLISHED"))
+}
+
+func TestBasePlanRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	basePlan, diags := testBasePlan().basePlan(ctx)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, basePlan.PrepaidBasePlanType)
+	assert.Equal(t, "P7D", basePlan.AutoRenewingBasePlanType.GracePeriodDuration)
+	assert.Equal(t, []*androidpublisher.RegionalBasePlanConfig{{
+		RegionCode:                "GB",
+		Price:                     &androidpublisher.Money{Units: 4, Nanos: 990000000, CurrencyCode: "GBP"},
+		NewSubscriberAvailability: true,
+		ForceSendFields:           []string{"NewSubscriberAvailability"},
+	}}, basePlan.RegionalConfigs)
+
+	// Settings chosen by Google Play are not managed
+	basePlan.State = "ACTIVE"
+	basePlan.AutoRenewingBasePlanType.ResubscribeState = "RESUBSCRIBE_STATE_ACTIVE"
+
+	model := testBasePlan()
+	assert.False(t, model.setBasePlan(ctx, basePlan).HasError())
+	assert.Equal(t, testBasePlan(), model)
+}
+
+func TestBasePlanImport(t *testing.T) {
+	model := basePlanResourceModel{OfferTags: types.SetNull(types.StringType)}
+	assert.False(t, model.setBasePlan(context.Background(), &androidpublisher.BasePlan{
+		State:               "INACTIVE",
+		PrepaidBasePlanType: &androidpublisher.PrepaidBasePlanType{BillingPeriodDuration: "P1Y", TimeExtension: "TIME_EXTENSION_ACTIVE"},
+	}).HasError())
+
+	assert.Equal(t, "inactive", model.State.ValueString())
+	assert.Nil(t, model.AutoRenewing)
+	assert.Equal(t, &prepaidModel{
+		BillingPeriod: types.StringValue("P1Y"),
+		TimeExtension: types.StringNull(),
+	}, model.Prepaid)
+}
+
+// testModifyBasePlan plans a change from the activated base plan in state
+// to planned.
+func testModifyBasePlan(t *testing.T, planned basePlanResourceModel) []string {
+	ctx := context.Background()
+
+	r := &SubscriptionBasePlanResource{}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	state := testBasePlan()
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: schemaResponse.Schema},
+		Plan:  tfsdk.Plan{Schema: schemaResponse.Schema},
+	}
+	assert.False(t, req.State.Set(ctx, &state).HasError())
+	assert.False(t, req.Plan.Set(ctx, &planned).HasError())
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	return diagnosticDetails(resp.Diagnostics)
+}
+
+func TestBasePlanModifyPlanActivatedBillingPeriod(t *testing.T) {
+	planned := testBasePlan()
+	planned.AutoRenewing.BillingPeriod = types.StringValue("P1Y")
+	details := testModifyBasePlan(t, planned)
+	assert.Len(t, details, 1)
+	assert.Contains(t, details[0], "Choose a new base_plan_id")
+
+	// A new base plan can have a different billing period
+	planned.BasePlanID = types.StringValue("yearly")
+	assert.Empty(t, testModifyBasePlan(t, planned))
+}
+
+func TestBasePlanModifyPlanActivatedType(t *testing.T) {
+	planned := testBasePlan()
+	planned.AutoRenewing = nil
+	planned.Prepaid = &prepaidModel{
+		BillingPeriod: types.StringValue("P1M"),
+		TimeExtension: types.StringNull(),
+	}
+	assert.Len(t, testModifyBasePlan(t, planned), 1)
+}
+
+func TestSaveBasePlanActivatesBeforeDeactivating(t *testing.T) {
+	var mask string
+	var patched androidpublisher.Subscription
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch:
+			mask = r.URL.Query().Get("updateMask")
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &patched))
+			_, _ = w.Write(body)
+		case strings.HasSuffix(r.URL.Path, ":deactivate"):
+			_, _ = w.Write([]byte(`{"productId": "premium", "basePlans": [{"basePlanId": "monthly", "state": "INACTIVE"}]}`))
+		case strings.HasSuffix(r.URL.Path, ":activate"):
+			_, _ = w.Write([]byte(`{"productId": "premium", "basePlans": [{"basePlanId": "monthly", "state": "ACTIVE"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"productId": "premium", "basePlans": [{"basePlanId": "yearly", "state": "ACTIVE"}]}`))
+		}
+	})
+
+	data := testBasePlan()
+	data.State = types.StringValue("inactive")
+	var diagnostics diag.Diagnostics
+	(&SubscriptionBasePlanResource{client: client}).saveBasePlan(context.Background(), &data, "draft", &diagnostics)
+	assert.Empty(t, diagnosticDetails(diagnostics))
+
+	// Other base plans of the subscription are kept
+	assert.Equal(t, "basePlans", mask)
+	assert.Len(t, patched.BasePlans, 2)
+	assert.Equal(t, "P1M", patched.BasePlans[1].AutoRenewingBasePlanType.BillingPeriodDuration)
+
+	assert.Equal(t, "inactive", data.State.ValueString())
+	assert.Equal(t, []string{
+		"GET /subscriptions/premium",
+		"PATCH /subscriptions/premium",
+		"POST /subscriptions/premium/basePlans/monthly:activate",
+		"POST /subscriptions/premium/basePlans/monthly:deactivate",
+	}, *requests)
+}
@@ -0,0 +1,944 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &SubscriptionOfferResource{}
+var _ resource.ResourceWithImportState = &SubscriptionOfferResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionOfferResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionOfferResource{}
+
+// Targeting rules are scoped to this subscription, any subscription in the
+// app, or one specific subscription.
+const (
+	scopeThisSubscription          = "this_subscription"
+	scopeAnySubscriptionInApp      = "any_subscription_in_app"
+	scopeSpecificSubscriptionInApp = "specific_subscription_in_app"
+)
+
+var acquisitionScopes = []string{scopeThisSubscription, scopeAnySubscriptionInApp}
+var upgradeScopes = []string{scopeThisSubscription, scopeSpecificSubscriptionInApp}
+
+func NewSubscriptionOfferResource() resource.Resource {
+	return &SubscriptionOfferResource{}
+}
+
+type SubscriptionOfferResource struct {
+	client *GooglePlayClient
+}
+
+type offerResourceModel struct {
+	ID              types.String                      `tfsdk:"id"`
+	PackageName     types.String                      `tfsdk:"package_name"`
+	ProductID       types.String                      `tfsdk:"product_id"`
+	BasePlanID      types.String                      `tfsdk:"base_plan_id"`
+	OfferID         types.String                      `tfsdk:"offer_id"`
+	State           types.String                      `tfsdk:"state"`
+	RegionsVersion  types.String                      `tfsdk:"regions_version"`
+	Phases          []offerPhaseModel                 `tfsdk:"phases"`
+	RegionalConfigs map[string]offerAvailabilityModel `tfsdk:"regional_configs"`
+	OtherRegions    *offerAvailabilityModel           `tfsdk:"other_regions"`
+	Targeting       *offerTargetingModel              `tfsdk:"targeting"`
+	OfferTags       types.Set                         `tfsdk:"offer_tags"`
+}
+
+type offerAvailabilityModel struct {
+	NewSubscriberAvailability types.Bool `tfsdk:"new_subscriber_availability"`
+}
+
+type offerPhaseModel struct {
+	Duration        types.String                    `tfsdk:"duration"`
+	RecurrenceCount types.Int64                     `tfsdk:"recurrence_count"`
+	RegionalConfigs map[string]offerPhasePriceModel `tfsdk:"regional_configs"`
+	OtherRegions    *offerPhaseOtherRegionsModel    `tfsdk:"other_regions"`
+}
+
+type offerPhasePriceModel struct {
+	Price            *moneyModel   `tfsdk:"price"`
+	AbsoluteDiscount *moneyModel   `tfsdk:"absolute_discount"`
+	RelativeDiscount types.Float64 `tfsdk:"relative_discount"`
+	Free             types.Bool    `tfsdk:"free"`
+}
+
+type offerPhaseOtherRegionsModel struct {
+	Prices            *otherRegionsPricesModel `tfsdk:"prices"`
+	AbsoluteDiscounts *otherRegionsPricesModel `tfsdk:"absolute_discounts"`
+	RelativeDiscount  types.Float64            `tfsdk:"relative_discount"`
+	Free              types.Bool               `tfsdk:"free"`
+}
+
+type otherRegionsPricesModel struct {
+	USD moneyModel `tfsdk:"usd"`
+	EUR moneyModel `tfsdk:"eur"`
+}
+
+type offerTargetingModel struct {
+	Acquisition *acquisitionRuleModel `tfsdk:"acquisition"`
+	Upgrade     *upgradeRuleModel     `tfsdk:"upgrade"`
+}
+
+type acquisitionRuleModel struct {
+	Scope types.String `tfsdk:"scope"`
+}
+
+type upgradeRuleModel struct {
+	Scope          types.String `tfsdk:"scope"`
+	SubscriptionID types.String `tfsdk:"subscription_id"`
+	BillingPeriod  types.String `tfsdk:"billing_period"`
+	OncePerUser    types.Bool   `tfsdk:"once_per_user"`
+}
+
+func (r *SubscriptionOfferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription_offer"
+}
+
+func offerPhasePriceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"price":             moneyAttribute("The price users pay in each recurrence of the phase", false),
+		"absolute_discount": moneyAttribute("The amount taken off the base plan price, prorated over the phase duration", false),
+		"relative_discount": schema.Float64Attribute{
+			MarkdownDescription: "The fraction taken off the base plan price, between 0 and 1, for example `0.5` for half price",
+			Optional:            true,
+		},
+		"free": schema.BoolAttribute{
+			MarkdownDescription: "Whether the phase is free, as a free trial",
+			Optional:            true,
+		},
+	}
+}
+
+func otherRegionsPricesAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"usd": moneyAttribute("The amount in US dollars", true),
+			"eur": moneyAttribute("The amount in euros", true),
+		},
+		Optional: true,
+	}
+}
+
+func offerAvailabilityAttributes(description string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"new_subscriber_availability": schema.BoolAttribute{
+			MarkdownDescription: description + ". Defaults to `true`",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	}
+}
+
+func (r *SubscriptionOfferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	otherRegionsPhaseAttributes := map[string]schema.Attribute{
+		"prices":             otherRegionsPricesAttribute("The price users pay in each recurrence of the phase"),
+		"absolute_discounts": otherRegionsPricesAttribute("The amount taken off the base plan price, prorated over the phase duration"),
+	}
+	for name, attribute := range offerPhasePriceAttributes() {
+		if name != "price" && name != "absolute_discount" {
+			otherRegionsPhaseAttributes[name] = attribute
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Manage an offer on a subscription base plan, such as a free trial or introductory price.
+		Activated offers cannot be deleted, so destroying one deactivates it instead`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the offer, in the format `package_name/product_id/base_plan_id/offer_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subscription the offer belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_plan_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the base plan the offer extends",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"offer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the offer, unique within the base plan",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the offer, one of: " + strings.Join(basePlanStates, ", ") + ". Defaults to `active`. Activated offers cannot return to `draft`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("active"),
+			},
+			"regions_version": regionsVersionAttribute("offer"),
+			"phases": schema.ListNestedAttribute{
+				MarkdownDescription: "One or two phases, which users go through in order before paying the base plan price. Only the first phase can be free",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"duration": schema.StringAttribute{
+							MarkdownDescription: "The ISO 8601 duration of a single recurrence of the phase, for example `P1W`",
+							Required:            true,
+						},
+						"recurrence_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of times the phase repeats. Defaults to `1`",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(1),
+						},
+						"regional_configs": schema.MapNestedAttribute{
+							MarkdownDescription: "The price of the phase keyed by region code, with exactly one of `price`, `absolute_discount`, `relative_discount` or `free`. Must include every region in the offer's `regional_configs`",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: offerPhasePriceAttributes(),
+							},
+							Required: true,
+						},
+						"other_regions": schema.SingleNestedAttribute{
+							MarkdownDescription: "The price of the phase in regions Google Play adds in future, with exactly one of `prices`, `absolute_discounts`, `relative_discount` or `free`. Required when the offer sets `other_regions`",
+							Attributes:          otherRegionsPhaseAttributes,
+							Optional:            true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"regional_configs": schema.MapNestedAttribute{
+				MarkdownDescription: "The regions where the offer is available, keyed by region code, for example `US`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: offerAvailabilityAttributes("Whether new users in the region can receive the offer"),
+				},
+				Required: true,
+			},
+			"other_regions": schema.SingleNestedAttribute{
+				MarkdownDescription: "The availability of the offer in regions Google Play adds in future",
+				Attributes:          offerAvailabilityAttributes("Whether new users in new regions can receive the offer"),
+				Optional:            true,
+			},
+			"targeting": schema.SingleNestedAttribute{
+				MarkdownDescription: "The users who are eligible for the offer. Set exactly one of `acquisition` or `upgrade`",
+				Attributes: map[string]schema.Attribute{
+					"acquisition": schema.SingleNestedAttribute{
+						MarkdownDescription: "Offer the phases to new subscribers",
+						Attributes: map[string]schema.Attribute{
+							"scope": schema.StringAttribute{
+								MarkdownDescription: "The subscriptions users must never have had, one of: " + strings.Join(acquisitionScopes, ", "),
+								Required:            true,
+							},
+						},
+						Optional: true,
+					},
+					"upgrade": schema.SingleNestedAttribute{
+						MarkdownDescription: "Offer the phases to existing subscribers who upgrade",
+						Attributes: map[string]schema.Attribute{
+							"scope": schema.StringAttribute{
+								MarkdownDescription: "The subscription users must currently have, one of: " + strings.Join(upgradeScopes, ", "),
+								Required:            true,
+							},
+							"subscription_id": schema.StringAttribute{
+								MarkdownDescription: "The product ID of the subscription users must have, when `scope` is `" + scopeSpecificSubscriptionInApp + "`",
+								Optional:            true,
+							},
+							"billing_period": schema.StringAttribute{
+								MarkdownDescription: "The ISO 8601 billing period users must currently have, for example `P1M`. Any billing period matches when unset",
+								Optional:            true,
+							},
+							"once_per_user": schema.BoolAttribute{
+								MarkdownDescription: "Whether each user can only receive the offer once",
+								Optional:            true,
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"offer_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags returned to the app with the offer's details",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SubscriptionOfferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubscriptionOfferResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Maps and objects cannot be read until they are known
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data offerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *SubscriptionOfferResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkRegionsVersion(ctx, r.client, req, resp)
+
+	// Offers can move to any state until they are activated
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var current, planned types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("state"), &current)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("state"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if current.ValueString() != "draft" && planned.ValueString() == "draft" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid offer state",
+			fmt.Sprintf("The offer has been activated, so it cannot return to draft. It is currently %s.", current.ValueString()),
+		)
+	}
+}
+
+func (r *SubscriptionOfferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/product_id/base_plan_id/offer_id
+	components := strings.Split(req.ID, "/")
+	if len(components) != 4 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/product_id/base_plan_id/offer_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product_id"), components[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("base_plan_id"), components[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("offer_id"), components[3])...)
+}
+
+func (r *SubscriptionOfferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data offerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, diags := data.offer(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// New offers are drafts until they are activated
+	offer, err := r.client.CreateSubscriptionOffer(ctx, offer, r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create subscription offer",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf(
+		"%s/%s/%s/%s",
+		data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString(), data.OfferID.ValueString(),
+	))
+	r.changeState(ctx, &data, offer, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data offerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.GetSubscriptionOffer(
+		ctx, data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString(), data.OfferID.ValueString(),
+	)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch subscription offer",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setOffer(ctx, offer)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data offerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, diags := data.offer(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := r.client.UpdateSubscriptionOffer(ctx, offer, r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update subscription offer",
+			err.Error(),
+		)
+		return
+	}
+
+	r.changeState(ctx, &data, offer, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionOfferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data offerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName, productID, basePlanID, offerID := data.PackageName.ValueString(), data.ProductID.ValueString(), data.BasePlanID.ValueString(), data.OfferID.ValueString()
+
+	var err error
+	switch data.State.ValueString() {
+	case "draft":
+		err = r.client.DeleteSubscriptionOffer(ctx, packageName, productID, basePlanID, offerID)
+	case "active":
+		_, err = r.client.DeactivateSubscriptionOffer(ctx, packageName, productID, basePlanID, offerID)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subscription offer, got error: %s", err))
+		return
+	}
+
+	if data.State.ValueString() != "draft" {
+		resp.Diagnostics.AddWarning(
+			"Offer left inactive",
+			fmt.Sprintf(
+				"Google Play does not allow activated offers to be deleted, so %s has been deactivated instead. "+
+					"Users who already received the offer keep it, but no new users can.",
+				offerID,
+			),
+		)
+	}
+}
+
+// changeState moves offer to the planned state, and records the result.
+func (r *SubscriptionOfferResource) changeState(
+	ctx context.Context,
+	data *offerResourceModel,
+	offer *androidpublisher.SubscriptionOffer,
+	diagnostics *diag.Diagnostics,
+) {
+	// Offers share the lifecycle of base plans
+	for _, transition := range basePlanTransitions(basePlanState(offer.State), data.State.ValueString()) {
+		var err error
+		if transition == "active" {
+			offer, err = r.client.ActivateSubscriptionOffer(ctx, offer.PackageName, offer.ProductId, offer.BasePlanId, offer.OfferId)
+		} else {
+			offer, err = r.client.DeactivateSubscriptionOffer(ctx, offer.PackageName, offer.ProductId, offer.BasePlanId, offer.OfferId)
+		}
+		if err != nil {
+			diagnostics.AddError(
+				fmt.Sprintf("Failed to change subscription offer to %s", transition),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	diagnostics.Append(data.setOffer(ctx, offer)...)
+}
+
+// validate returns an error for each part of the offer which Google Play
+// would reject, including phases in an order it does not allow.
+func (data offerResourceModel) validate() diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if len(data.Phases) == 0 || len(data.Phases) > 2 {
+		diagnostics.AddAttributeError(
+			path.Root("phases"),
+			"Invalid offer phases",
+			fmt.Sprintf("An offer must have one or two phases, got %d.", len(data.Phases)),
+		)
+	}
+
+	regions := slices.Sorted(maps.Keys(data.RegionalConfigs))
+	for i, phase := range data.Phases {
+		phasePath := path.Root("phases").AtListIndex(i)
+
+		missing, unexpected := diffCountries(regions, slices.Sorted(maps.Keys(phase.RegionalConfigs)))
+		if len(missing) > 0 || len(unexpected) > 0 {
+			diagnostics.AddAttributeError(
+				phasePath.AtName("regional_configs"),
+				"Invalid offer phase regions",
+				fmt.Sprintf(
+					"Each phase must have a regional config for every region in the offer's regional_configs.\n\nMissing: %s\nUnexpected: %s",
+					countryList(missing), countryList(unexpected),
+				),
+			)
+		}
+
+		free := 0
+		for _, region := range slices.Sorted(maps.Keys(phase.RegionalConfigs)) {
+			config := phase.RegionalConfigs[region]
+			configPath := phasePath.AtName("regional_configs").AtMapKey(region)
+			diagnostics.Append(validatePhasePrice(configPath, config.Price != nil, config.AbsoluteDiscount != nil, config.RelativeDiscount, config.Free)...)
+			if config.Free.ValueBool() {
+				free++
+			}
+		}
+
+		if other := phase.OtherRegions; other != nil {
+			diagnostics.Append(validatePhasePrice(phasePath.AtName("other_regions"), other.Prices != nil, other.AbsoluteDiscounts != nil, other.RelativeDiscount, other.Free)...)
+		}
+		if (phase.OtherRegions == nil) != (data.OtherRegions == nil) {
+			diagnostics.AddAttributeError(
+				phasePath.AtName("other_regions"),
+				"Invalid offer phase regions",
+				"Each phase must set other_regions if, and only if, the offer sets other_regions.",
+			)
+		}
+
+		if free > 0 && free < len(phase.RegionalConfigs) {
+			diagnostics.AddAttributeError(
+				phasePath.AtName("regional_configs"),
+				"Invalid offer phase",
+				fmt.Sprintf("Phase %d is free in some regions but not others. A phase must be free in every region, or in none.", i+1),
+			)
+		}
+
+		isFree := free > 0
+		switch {
+		case isFree && i > 0:
+			diagnostics.AddAttributeError(
+				phasePath,
+				"Invalid offer phase order",
+				"Only the first phase can be free. Put the free trial before the introductory price.",
+			)
+		case !isFree && i == 0 && len(data.Phases) == 2:
+			diagnostics.AddAttributeError(
+				phasePath,
+				"Invalid offer phase order",
+				"When an offer has two phases, the first must be a free trial.",
+			)
+		}
+		if isFree && phase.RecurrenceCount.ValueInt64() > 1 {
+			diagnostics.AddAttributeError(
+				phasePath.AtName("recurrence_count"),
+				"Invalid offer phase",
+				"A free trial cannot recur. Use a longer duration instead.",
+			)
+		}
+	}
+
+	if targeting := data.Targeting; targeting != nil {
+		targetingPath := path.Root("targeting")
+		if (targeting.Acquisition == nil) == (targeting.Upgrade == nil) {
+			diagnostics.AddAttributeError(
+				targetingPath,
+				"Invalid offer targeting",
+				"Exactly one of acquisition or upgrade must be set.",
+			)
+		}
+		if rule := targeting.Acquisition; rule != nil && !slices.Contains(acquisitionScopes, rule.Scope.ValueString()) {
+			diagnostics.AddAttributeError(
+				targetingPath.AtName("acquisition").AtName("scope"),
+				"Invalid offer targeting",
+				fmt.Sprintf("'%s' is not a valid acquisition scope, expected one of: %s.", rule.Scope.ValueString(), strings.Join(acquisitionScopes, ", ")),
+			)
+		}
+		if rule := targeting.Upgrade; rule != nil {
+			scope := rule.Scope.ValueString()
+			if !slices.Contains(upgradeScopes, scope) {
+				diagnostics.AddAttributeError(
+					targetingPath.AtName("upgrade").AtName("scope"),
+					"Invalid offer targeting",
+					fmt.Sprintf("'%s' is not a valid upgrade scope, expected one of: %s.", scope, strings.Join(upgradeScopes, ", ")),
+				)
+			}
+			if (scope == scopeSpecificSubscriptionInApp) == rule.SubscriptionID.IsNull() {
+				diagnostics.AddAttributeError(
+					targetingPath.AtName("upgrade").AtName("subscription_id"),
+					"Invalid offer targeting",
+					fmt.Sprintf("subscription_id must be set if, and only if, scope is %s.", scopeSpecificSubscriptionInApp),
+				)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// validatePhasePrice checks that a phase sets exactly one way of pricing a
+// region.
+func validatePhasePrice(at path.Path, price bool, absoluteDiscount bool, relativeDiscount types.Float64, free types.Bool) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	set := 0
+	for _, ok := range []bool{price, absoluteDiscount, !relativeDiscount.IsNull(), free.ValueBool()} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		diagnostics.AddAttributeError(
+			at,
+			"Invalid offer phase price",
+			"Set exactly one of a price, an absolute discount, a relative discount or free.",
+		)
+	}
+
+	if discount := relativeDiscount.ValueFloat64(); !relativeDiscount.IsNull() && (discount <= 0 || discount >= 1) {
+		diagnostics.AddAttributeError(
+			at.AtName("relative_discount"),
+			"Invalid offer phase price",
+			fmt.Sprintf("relative_discount must be between 0 and 1, got %g.", discount),
+		)
+	}
+
+	return diagnostics
+}
+
+// offer builds the API representation of the planned offer.
+func (data offerResourceModel) offer(ctx context.Context) (*androidpublisher.SubscriptionOffer, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	offer := &androidpublisher.SubscriptionOffer{
+		PackageName: data.PackageName.ValueString(),
+		ProductId:   data.ProductID.ValueString(),
+		BasePlanId:  data.BasePlanID.ValueString(),
+		OfferId:     data.OfferID.ValueString(),
+	}
+
+	for _, phase := range data.Phases {
+		offerPhase := &androidpublisher.SubscriptionOfferPhase{
+			Duration:        phase.Duration.ValueString(),
+			RecurrenceCount: phase.RecurrenceCount.ValueInt64(),
+		}
+		for _, region := range slices.Sorted(maps.Keys(phase.RegionalConfigs)) {
+			config := phase.RegionalConfigs[region]
+			regional := &androidpublisher.RegionalSubscriptionOfferPhaseConfig{
+				RegionCode:       region,
+				RelativeDiscount: config.RelativeDiscount.ValueFloat64(),
+			}
+			if config.Price != nil {
+				regional.Price = config.Price.money()
+			}
+			if config.AbsoluteDiscount != nil {
+				regional.AbsoluteDiscount = config.AbsoluteDiscount.money()
+			}
+			if config.Free.ValueBool() {
+				regional.Free = &androidpublisher.RegionalSubscriptionOfferPhaseFreePriceOverride{}
+			}
+			offerPhase.RegionalConfigs = append(offerPhase.RegionalConfigs, regional)
+		}
+		if other := phase.OtherRegions; other != nil {
+			offerPhase.OtherRegionsConfig = &androidpublisher.OtherRegionsSubscriptionOfferPhaseConfig{
+				OtherRegionsPrices: other.Prices.prices(),
+				AbsoluteDiscounts:  other.AbsoluteDiscounts.prices(),
+				RelativeDiscount:   other.RelativeDiscount.ValueFloat64(),
+			}
+			if other.Free.ValueBool() {
+				offerPhase.OtherRegionsConfig.Free = &androidpublisher.OtherRegionsSubscriptionOfferPhaseFreePriceOverride{}
+			}
+		}
+		offer.Phases = append(offer.Phases, offerPhase)
+	}
+
+	for _, region := range slices.Sorted(maps.Keys(data.RegionalConfigs)) {
+		offer.RegionalConfigs = append(offer.RegionalConfigs, &androidpublisher.RegionalSubscriptionOfferConfig{
+			RegionCode:                region,
+			NewSubscriberAvailability: data.RegionalConfigs[region].NewSubscriberAvailability.ValueBool(),
+			ForceSendFields:           []string{"NewSubscriberAvailability"},
+		})
+	}
+	if other := data.OtherRegions; other != nil {
+		offer.OtherRegionsConfig = &androidpublisher.OtherRegionsSubscriptionOfferConfig{
+			OtherRegionsNewSubscriberAvailability: other.NewSubscriberAvailability.ValueBool(),
+			ForceSendFields:                       []string{"OtherRegionsNewSubscriberAvailability"},
+		}
+	}
+
+	if targeting := data.Targeting; targeting != nil {
+		offer.Targeting = &androidpublisher.SubscriptionOfferTargeting{}
+		if rule := targeting.Acquisition; rule != nil {
+			offer.Targeting.AcquisitionRule = &androidpublisher.AcquisitionTargetingRule{
+				Scope: targetingScope(rule.Scope.ValueString(), ""),
+			}
+		}
+		if rule := targeting.Upgrade; rule != nil {
+			offer.Targeting.UpgradeRule = &androidpublisher.UpgradeTargetingRule{
+				Scope:                 targetingScope(rule.Scope.ValueString(), rule.SubscriptionID.ValueString()),
+				BillingPeriodDuration: rule.BillingPeriod.ValueString(),
+				OncePerUser:           rule.OncePerUser.ValueBool(),
+			}
+		}
+	}
+
+	tags := []string{}
+	diagnostics.Append(data.OfferTags.ElementsAs(ctx, &tags, false)...)
+	for _, tag := range tags {
+		offer.OfferTags = append(offer.OfferTags, &androidpublisher.OfferTag{Tag: tag})
+	}
+
+	return offer, diagnostics
+}
+
+// setOffer records offer.
+func (data *offerResourceModel) setOffer(ctx context.Context, offer *androidpublisher.SubscriptionOffer) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	data.State = types.StringValue(basePlanState(offer.State))
+
+	phases := []offerPhaseModel{}
+	for i, offerPhase := range offer.Phases {
+		var prior *offerPhaseModel
+		if i < len(data.Phases) {
+			prior = &data.Phases[i]
+		}
+
+		phase := offerPhaseModel{
+			Duration:        types.StringValue(offerPhase.Duration),
+			RecurrenceCount: types.Int64Value(offerPhase.RecurrenceCount),
+			RegionalConfigs: map[string]offerPhasePriceModel{},
+		}
+		for _, regional := range offerPhase.RegionalConfigs {
+			config := offerPhasePriceModel{
+				Price:            moneyPointer(regional.Price),
+				AbsoluteDiscount: moneyPointer(regional.AbsoluteDiscount),
+				RelativeDiscount: optionalFloat64(regional.RelativeDiscount),
+				Free:             types.BoolNull(),
+			}
+			if regional.Free != nil {
+				config.Free = types.BoolValue(true)
+			} else if prior != nil && !prior.RegionalConfigs[regional.RegionCode].Free.IsNull() {
+				config.Free = types.BoolValue(false)
+			}
+			phase.RegionalConfigs[regional.RegionCode] = config
+		}
+		if other := offerPhase.OtherRegionsConfig; other != nil {
+			phase.OtherRegions = &offerPhaseOtherRegionsModel{
+				Prices:            otherRegionsPricesValue(other.OtherRegionsPrices),
+				AbsoluteDiscounts: otherRegionsPricesValue(other.AbsoluteDiscounts),
+				RelativeDiscount:  optionalFloat64(other.RelativeDiscount),
+				Free:              types.BoolNull(),
+			}
+			if other.Free != nil {
+				phase.OtherRegions.Free = types.BoolValue(true)
+			} else if prior != nil && prior.OtherRegions != nil && !prior.OtherRegions.Free.IsNull() {
+				phase.OtherRegions.Free = types.BoolValue(false)
+			}
+		}
+		phases = append(phases, phase)
+	}
+	data.Phases = phases
+
+	configs := map[string]offerAvailabilityModel{}
+	for _, regional := range offer.RegionalConfigs {
+		configs[regional.RegionCode] = offerAvailabilityModel{
+			NewSubscriberAvailability: types.BoolValue(regional.NewSubscriberAvailability),
+		}
+	}
+	data.RegionalConfigs = configs
+
+	data.OtherRegions = nil
+	if other := offer.OtherRegionsConfig; other != nil {
+		data.OtherRegions = &offerAvailabilityModel{
+			NewSubscriberAvailability: types.BoolValue(other.OtherRegionsNewSubscriberAvailability),
+		}
+	}
+
+	data.Targeting = data.Targeting.update(offer.Targeting)
+
+	tags := []string{}
+	for _, tag := range offer.OfferTags {
+		tags = append(tags, tag.Tag)
+	}
+	if !data.OfferTags.IsNull() || len(tags) > 0 {
+		data.OfferTags = stringSetValue(ctx, tags, &diagnostics)
+	}
+
+	return diagnostics
+}
+
+// update returns targeting as recorded by the resource. Whether the offer is
+// once per user is only recorded if m sets it, or it is true.
+func (m *offerTargetingModel) update(targeting *androidpublisher.SubscriptionOfferTargeting) *offerTargetingModel {
+	if targeting == nil || (targeting.AcquisitionRule == nil && targeting.UpgradeRule == nil) {
+		return nil
+	}
+
+	updated := &offerTargetingModel{}
+	if rule := targeting.AcquisitionRule; rule != nil {
+		scope, _ := targetingScopeValue(rule.Scope)
+		updated.Acquisition = &acquisitionRuleModel{
+			Scope: types.StringValue(scope),
+		}
+	}
+	if rule := targeting.UpgradeRule; rule != nil {
+		scope, subscriptionID := targetingScopeValue(rule.Scope)
+		updated.Upgrade = &upgradeRuleModel{
+			Scope:          types.StringValue(scope),
+			SubscriptionID: optionalString(subscriptionID),
+			BillingPeriod:  optionalString(rule.BillingPeriodDuration),
+			OncePerUser:    types.BoolNull(),
+		}
+		if rule.OncePerUser || (m != nil && m.Upgrade != nil && !m.Upgrade.OncePerUser.IsNull()) {
+			updated.Upgrade.OncePerUser = types.BoolValue(rule.OncePerUser)
+		}
+	}
+	return updated
+}
+
+func targetingScope(scope string, subscriptionID string) *androidpublisher.TargetingRuleScope {
+	switch scope {
+	case scopeAnySubscriptionInApp:
+		return &androidpublisher.TargetingRuleScope{AnySubscriptionInApp: &androidpublisher.TargetingRuleScopeAnySubscriptionInApp{}}
+	case scopeSpecificSubscriptionInApp:
+		return &androidpublisher.TargetingRuleScope{SpecificSubscriptionInApp: subscriptionID}
+	}
+	return &androidpublisher.TargetingRuleScope{ThisSubscription: &androidpublisher.TargetingRuleScopeThisSubscription{}}
+}
+
+// targetingScopeValue returns the name of scope, and the subscription it
+// names when it targets a specific subscription.
+func targetingScopeValue(scope *androidpublisher.TargetingRuleScope) (string, string) {
+	switch {
+	case scope == nil || scope.ThisSubscription != nil:
+		return scopeThisSubscription, ""
+	case scope.AnySubscriptionInApp != nil:
+		return scopeAnySubscriptionInApp, ""
+	}
+	return scopeSpecificSubscriptionInApp, scope.SpecificSubscriptionInApp
+}
+
+func (m *otherRegionsPricesModel) prices() *androidpublisher.OtherRegionsSubscriptionOfferPhasePrices {
+	if m == nil {
+		return nil
+	}
+	return &androidpublisher.OtherRegionsSubscriptionOfferPhasePrices{
+		UsdPrice: m.USD.money(),
+		EurPrice: m.EUR.money(),
+	}
+}
+
+func otherRegionsPricesValue(prices *androidpublisher.OtherRegionsSubscriptionOfferPhasePrices) *otherRegionsPricesModel {
+	if prices == nil || prices.UsdPrice == nil || prices.EurPrice == nil {
+		return nil
+	}
+	return &otherRegionsPricesModel{
+		USD: moneyValue(prices.UsdPrice),
+		EUR: moneyValue(prices.EurPrice),
+	}
+}
+
+func moneyPointer(money *androidpublisher.Money) *moneyModel {
+	if money == nil {
+		return nil
+	}
+	value := moneyValue(money)
+	return &value
+}
+
+// optionalFloat64 converts a zero returned by the API to null.
+func optionalFloat64(value float64) types.Float64 {
+	if value == 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testOfferPhase(duration string, prices map[string]offerPhasePriceModel) offerPhaseModel {
+	return offerPhaseModel{
+		Duration:        types.StringValue(duration),
+		RecurrenceCount: types.Int64Value(1),
+		RegionalConfigs: prices,
+	}
+}
+
+func freePhasePrice() offerPhasePriceModel {
+	return offerPhasePriceModel{
+		RelativeDiscount: types.Float64Null(),
+		Free:             types.BoolValue(true),
+	}
+}
+
+func discountPhasePrice(discount float64) offerPhasePriceModel {
+	return offerPhasePriceModel{
+		RelativeDiscount: types.Float64Value(discount),
+		Free:             types.BoolNull(),
+	}
+}
+
+func testOffer() offerResourceModel {
+	return offerResourceModel{
+		ID:             types.StringValue("com.example.app/premium/monthly/trial"),
+		PackageName:    types.StringValue("com.example.app"),
+		ProductID:      types.StringValue("premium"),
+		BasePlanID:     types.StringValue("monthly"),
+		OfferID:        types.StringValue("trial"),
+		State:          types.StringValue("active"),
+		RegionsVersion: types.StringNull(),
+		Phases: []offerPhaseModel{
+			testOfferPhase("P1W", map[string]offerPhasePriceModel{"GB": freePhasePrice()}),
+			testOfferPhase("P1M", map[string]offerPhasePriceModel{"GB": discountPhasePrice(0.5)}),
+		},
+		RegionalConfigs: map[string]offerAvailabilityModel{
+			"GB": {NewSubscriberAvailability: types.BoolValue(true)},
+		},
+		Targeting: &offerTargetingModel{
+			Acquisition: &acquisitionRuleModel{Scope: types.StringValue(scopeThisSubscription)},
+		},
+		OfferTags: types.SetNull(types.StringType),
+	}
+}
+
+func diagnosticDetails(diagnostics diag.Diagnostics) []string {
+	details := []string{}
+	for _, diagnostic := range diagnostics {
+		details = append(details, diagnostic.Detail())
+	}
+	return details
+}
+
+func TestOfferValidate(t *testing.T) {
+	assert.Empty(t, testOffer().validate())
+
+	offer := testOffer()
+	offer.Phases[0], offer.Phases[1] = offer.Phases[1], offer.Phases[0]
+	assert.Equal(t, []string{
+		"When an offer has two phases, the first must be a free trial.",
+		"Only the first phase can be free. Put the free trial before the introductory price.",
+	}, diagnosticDetails(offer.validate()))
+
+	offer = testOffer()
+	offer.Phases = offer.Phases[:1]
+	offer.Phases[0].RecurrenceCount = types.Int64Value(2)
+	offer.Phases[0].RegionalConfigs["US"] = discountPhasePrice(1.5)
+	assert.Equal(t, []string{
+		"Each phase must have a regional config for every region in the offer's regional_configs.\n\nMissing: none\nUnexpected: US",
+		"relative_discount must be between 0 and 1, got 1.5.",
+		"Phase 1 is free in some regions but not others. A phase must be free in every region, or in none.",
+		"A free trial cannot recur. Use a longer duration instead.",
+	}, diagnosticDetails(offer.validate()))
+}
+
+func TestOfferValidateTargeting(t *testing.T) {
+	offer := testOffer()
+	offer.Targeting.Upgrade = &upgradeRuleModel{
+		Scope:          types.StringValue(scopeSpecificSubscriptionInApp),
+		SubscriptionID: types.StringNull(),
+	}
+	assert.Equal(t, []string{
+		"Exactly one of acquisition or upgrade must be set.",
+		"subscription_id must be set if, and only if, scope is specific_subscription_in_app.",
+	}, diagnosticDetails(offer.validate()))
+}
+
+func TestOfferRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	offer, diags := testOffer().offer(ctx)
+	assert.False(t, diags.HasError())
+	assert.NotNil(t, offer.Phases[0].RegionalConfigs[0].Free)
+	assert.Equal(t, 0.5, offer.Phases[1].RegionalConfigs[0].RelativeDiscount)
+	assert.NotNil(t, offer.Targeting.AcquisitionRule.Scope.ThisSubscription)
+
+	offer.State = "ACTIVE"
+	model := testOffer()
+	assert.False(t, model.setOffer(ctx, offer).HasError())
+	assert.Equal(t, testOffer(), model)
+}
+
+func TestTargetingScopeValue(t *testing.T) {
+	scope, subscriptionID := targetingScopeValue(targetingScope(scopeSpecificSubscriptionInApp, "basic"))
+	assert.Equal(t, scopeSpecificSubscriptionInApp, scope)
+	assert.Equal(t, "basic", subscriptionID)
+
+	scope, _ = targetingScopeValue(&androidpublisher.TargetingRuleScope{AnySubscriptionInApp: &androidpublisher.TargetingRuleScopeAnySubscriptionInApp{}})
+	assert.Equal(t, scopeAnySubscriptionInApp, scope)
+}
+
+func TestOfferUpdatePatchesThenDeactivates(t *testing.T) {
+	ctx := context.Background()
+
+	var mask string
+	var patched map[string]interface{}
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			mask = r.URL.Query().Get("updateMask")
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &patched))
+		}
+
+		offer := map[string]interface{}{}
+		for key, value := range patched {
+			offer[key] = value
+		}
+		offer["state"] = "ACTIVE"
+		if strings.HasSuffix(r.URL.Path, ":deactivate") {
+			offer["state"] = "INACTIVE"
+		}
+		body, _ := json.Marshal(offer)
+		_, _ = w.Write(body)
+	})
+
+	r := &SubscriptionOfferResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	data := testOffer()
+	data.State = types.StringValue("inactive")
+	req := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResponse.Schema}}
+	assert.False(t, req.Plan.Set(ctx, &data).HasError())
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	r.Update(ctx, req, resp)
+	assert.Empty(t, diagnosticDetails(resp.Diagnostics))
+
+	assert.Equal(t, subscriptionOfferUpdateMask, mask)
+	assert.Len(t, patched["phases"], 2)
+	assert.Equal(t, map[string]interface{}{
+		"acquisitionRule": map[string]interface{}{
+			"scope": map[string]interface{}{"thisSubscription": map[string]interface{}{}},
+		},
+	}, patched["targeting"])
+	assert.Equal(t, []string{
+		"PATCH /subscriptions/premium/basePlans/monthly/offers/trial",
+		"POST /subscriptions/premium/basePlans/monthly/offers/trial:deactivate",
+	}, *requests)
+
+	var state offerResourceModel
+	assert.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "inactive", state.State.ValueString())
+}
@@ -0,0 +1,574 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &SubscriptionResource{}
+var _ resource.ResourceWithImportState = &SubscriptionResource{}
+var _ resource.ResourceWithValidateConfig = &SubscriptionResource{}
+var _ resource.ResourceWithModifyPlan = &SubscriptionResource{}
+
+// eeaWithdrawalRightTypes classify a subscription for EU consumer law.
+var eeaWithdrawalRightTypes = []string{"WITHDRAWAL_RIGHT_DIGITAL_CONTENT", "WITHDRAWAL_RIGHT_SERVICE"}
+
+func NewSubscriptionResource() resource.Resource {
+	return &SubscriptionResource{}
+}
+
+type SubscriptionResource struct {
+	client *GooglePlayClient
+}
+
+type subscriptionResourceModel struct {
+	ID                         types.String                        `tfsdk:"id"`
+	PackageName                types.String                        `tfsdk:"package_name"`
+	ProductID                  types.String                        `tfsdk:"product_id"`
+	RegionsVersion             types.String                        `tfsdk:"regions_version"`
+	Listings                   map[string]subscriptionListingModel `tfsdk:"listings"`
+	TaxAndCompliance           *subscriptionTaxModel               `tfsdk:"tax_and_compliance"`
+	RestrictedPaymentCountries types.Set                           `tfsdk:"restricted_payment_countries"`
+	Archived                   types.Bool                          `tfsdk:"archived"`
+}
+
+type subscriptionListingModel struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Benefits    types.List   `tfsdk:"benefits"`
+}
+
+type subscriptionTaxModel struct {
+	EEAWithdrawalRightType  types.String            `tfsdk:"eea_withdrawal_right_type"`
+	TokenizedDigitalAsset   types.Bool              `tfsdk:"tokenized_digital_asset"`
+	ProductTaxCategoryCode  types.String            `tfsdk:"product_tax_category_code"`
+	TaxRateInfoByRegionCode map[string]taxRateModel `tfsdk:"tax_rates"`
+}
+
+type taxRateModel struct {
+	EligibleForStreamingServiceTaxRate types.Bool   `tfsdk:"eligible_for_streaming_service_tax_rate"`
+	StreamingTaxType                   types.String `tfsdk:"streaming_tax_type"`
+	TaxTier                            types.String `tfsdk:"tax_tier"`
+}
+
+func (r *SubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
+
+func (r *SubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Manage a subscription product. Its base plans and offers are managed by the
+		` + "`googleplay_subscription_base_plan`" + ` and ` + "`googleplay_subscription_offer`" + ` resources`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subscription, in the format `package_name/product_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subscription, unique within the app",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"regions_version": regionsVersionAttribute("subscription"),
+			"listings": schema.MapNestedAttribute{
+				MarkdownDescription: "The title and benefits of the subscription shown to users, keyed by BCP-47 language tag",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the subscription",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the subscription",
+							Optional:            true,
+						},
+						"benefits": schema.ListAttribute{
+							MarkdownDescription: "Up to four benefits of the subscription",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"tax_and_compliance": schema.SingleNestedAttribute{
+				MarkdownDescription: "Tax and legal compliance settings. Only the settings given here are managed",
+				Attributes: map[string]schema.Attribute{
+					"eea_withdrawal_right_type": schema.StringAttribute{
+						MarkdownDescription: "How the subscription is classified for the right of withdrawal in the EEA, one of: " + strings.Join(eeaWithdrawalRightTypes, ", "),
+						Optional:            true,
+					},
+					"tokenized_digital_asset": schema.BoolAttribute{
+						MarkdownDescription: "Whether the subscription is a tokenized digital asset",
+						Optional:            true,
+					},
+					"product_tax_category_code": schema.StringAttribute{
+						MarkdownDescription: "The product tax category, which determines the tax rates applied to the subscription",
+						Optional:            true,
+					},
+					"tax_rates": taxRatesAttribute("subscription"),
+				},
+				Optional: true,
+			},
+			"restricted_payment_countries": schema.SetAttribute{
+				MarkdownDescription: "Region codes where the subscription can only be bought with a payment method registered in the same country",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subscription has been archived",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// taxRatesAttribute describes the regional tax settings of a kind of product.
+func taxRatesAttribute(product string) schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Tax details for specific regions, keyed by region code",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"eligible_for_streaming_service_tax_rate": schema.BoolAttribute{
+					MarkdownDescription: "Whether the " + product + " is eligible for the reduced streaming service tax rate",
+					Optional:            true,
+				},
+				"streaming_tax_type": schema.StringAttribute{
+					MarkdownDescription: "The US communications or amusement tax category, for example `STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL`",
+					Optional:            true,
+				},
+				"tax_tier": schema.StringAttribute{
+					MarkdownDescription: "The reduced tax tier, for example `TAX_TIER_NEWS_1`",
+					Optional:            true,
+				},
+			},
+		},
+		Optional: true,
+	}
+}
+
+func (r *SubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Maps and objects cannot be read until they are known
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data subscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Listings) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("listings"),
+			"Missing listing",
+			"A subscription must have a listing in at least one language.",
+		)
+	}
+	for _, language := range slices.Sorted(maps.Keys(data.Listings)) {
+		if benefits := data.Listings[language].Benefits; len(benefits.Elements()) > 4 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("listings").AtMapKey(language).AtName("benefits"),
+				"Too many benefits",
+				fmt.Sprintf("The %s listing has %d benefits, but at most 4 are allowed.", language, len(benefits.Elements())),
+			)
+		}
+	}
+
+	if data.TaxAndCompliance != nil && !data.TaxAndCompliance.EEAWithdrawalRightType.IsNull() {
+		rightType := data.TaxAndCompliance.EEAWithdrawalRightType.ValueString()
+		if !slices.Contains(eeaWithdrawalRightTypes, rightType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tax_and_compliance").AtName("eea_withdrawal_right_type"),
+				"Invalid withdrawal right type",
+				fmt.Sprintf("'%s' is not a valid withdrawal right type, expected one of: %s.", rightType, strings.Join(eeaWithdrawalRightTypes, ", ")),
+			)
+		}
+	}
+}
+
+func (r *SubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkRegionsVersion(ctx, r.client, req, resp)
+}
+
+func (r *SubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/product_id
+	components := strings.Split(req.ID, "/")
+	if len(components) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/product_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("product_id"), components[1])...)
+}
+
+func (r *SubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data subscriptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, diags := data.subscription(ctx, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.CreateSubscription(ctx, subscription, r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create subscription",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.PackageName.ValueString(), data.ProductID.ValueString()))
+	resp.Diagnostics.Append(data.setSubscription(ctx, subscription)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data subscriptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.GetSubscription(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch subscription",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSubscription(ctx, subscription)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data subscriptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tax rates are saved for every region at once, so the rates of regions
+	// which are not managed are read first to keep them
+	var current map[string]androidpublisher.RegionalTaxRateInfo
+	if data.TaxAndCompliance != nil && data.TaxAndCompliance.TaxRateInfoByRegionCode != nil {
+		subscription, err := r.client.GetSubscription(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to fetch subscription",
+				err.Error(),
+			)
+			return
+		}
+		if subscription.TaxAndComplianceSettings != nil {
+			current = subscription.TaxAndComplianceSettings.TaxRateInfoByRegionCode
+		}
+	}
+
+	subscription, diags := data.subscription(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := r.client.UpdateSubscription(ctx, subscription, data.updateMask(), r.client.RegionsVersion(data.RegionsVersion))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update subscription",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setSubscription(ctx, subscription)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data subscriptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Subscriptions can only be deleted if none of their base plans were ever activated
+	err := r.client.DeleteSubscription(ctx, data.PackageName.ValueString(), data.ProductID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subscription, got error: %s", err))
+		return
+	}
+}
+
+// subscription builds the API representation of the planned subscription.
+// Only the settings which are configured are set. The configured tax rates
+// are merged into currentRates, the rates which are already saved.
+func (data subscriptionResourceModel) subscription(
+	ctx context.Context,
+	currentRates map[string]androidpublisher.RegionalTaxRateInfo,
+) (*androidpublisher.Subscription, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	subscription := &androidpublisher.Subscription{
+		PackageName: data.PackageName.ValueString(),
+		ProductId:   data.ProductID.ValueString(),
+	}
+
+	for _, language := range slices.Sorted(maps.Keys(data.Listings)) {
+		listing := data.Listings[language]
+		benefits := []string{}
+		diagnostics.Append(listing.Benefits.ElementsAs(ctx, &benefits, false)...)
+		subscription.Listings = append(subscription.Listings, &androidpublisher.SubscriptionListing{
+			LanguageCode: language,
+			Title:        listing.Title.ValueString(),
+			Description:  listing.Description.ValueString(),
+			Benefits:     benefits,
+		})
+	}
+
+	if !data.RestrictedPaymentCountries.IsNull() {
+		subscription.RestrictedPaymentCountries = &androidpublisher.RestrictedPaymentCountries{}
+		diagnostics.Append(data.RestrictedPaymentCountries.ElementsAs(ctx, &subscription.RestrictedPaymentCountries.RegionCodes, false)...)
+	}
+
+	if tax := data.TaxAndCompliance; tax != nil {
+		settings := &androidpublisher.SubscriptionTaxAndComplianceSettings{
+			EeaWithdrawalRightType: tax.EEAWithdrawalRightType.ValueString(),
+			ProductTaxCategoryCode: tax.ProductTaxCategoryCode.ValueString(),
+		}
+		if !tax.TokenizedDigitalAsset.IsNull() {
+			settings.IsTokenizedDigitalAsset = tax.TokenizedDigitalAsset.ValueBool()
+			settings.ForceSendFields = []string{"IsTokenizedDigitalAsset"}
+		}
+		if tax.TaxRateInfoByRegionCode != nil {
+			settings.TaxRateInfoByRegionCode = mergeTaxRates(currentRates, tax.TaxRateInfoByRegionCode)
+		}
+		subscription.TaxAndComplianceSettings = settings
+	}
+
+	return subscription, diagnostics
+}
+
+// updateMask lists the fields of the subscription which are configured, so
+// that settings which are not managed keep the values set in Play Console.
+func (data subscriptionResourceModel) updateMask() []string {
+	mask := []string{"listings"}
+	if !data.RestrictedPaymentCountries.IsNull() {
+		mask = append(mask, "restrictedPaymentCountries")
+	}
+	if tax := data.TaxAndCompliance; tax != nil {
+		if !tax.EEAWithdrawalRightType.IsNull() {
+			mask = append(mask, "taxAndComplianceSettings.eeaWithdrawalRightType")
+		}
+		if !tax.TokenizedDigitalAsset.IsNull() {
+			mask = append(mask, "taxAndComplianceSettings.isTokenizedDigitalAsset")
+		}
+		if !tax.ProductTaxCategoryCode.IsNull() {
+			mask = append(mask, "taxAndComplianceSettings.productTaxCategoryCode")
+		}
+		if tax.TaxRateInfoByRegionCode != nil {
+			mask = append(mask, "taxAndComplianceSettings.taxRateInfoByRegionCode")
+		}
+	}
+	return mask
+}
+
+// setSubscription records subscription. Optional settings which are not
+// managed by the resource are left unset.
+func (data *subscriptionResourceModel) setSubscription(ctx context.Context, subscription *androidpublisher.Subscription) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	data.Archived = types.BoolValue(subscription.Archived)
+
+	listings := map[string]subscriptionListingModel{}
+	for _, listing := range subscription.Listings {
+		benefits := types.ListNull(types.StringType)
+		if len(listing.Benefits) > 0 {
+			var diags diag.Diagnostics
+			benefits, diags = types.ListValueFrom(ctx, types.StringType, listing.Benefits)
+			diagnostics.Append(diags...)
+		}
+		listings[listing.LanguageCode] = subscriptionListingModel{
+			Title:       types.StringValue(listing.Title),
+			Description: optionalString(listing.Description),
+			Benefits:    benefits,
+		}
+	}
+	data.Listings = listings
+
+	regionCodes := []string{}
+	if subscription.RestrictedPaymentCountries != nil {
+		regionCodes = subscription.RestrictedPaymentCountries.RegionCodes
+	}
+	if !data.RestrictedPaymentCountries.IsNull() || len(regionCodes) > 0 {
+		data.RestrictedPaymentCountries = stringSetValue(ctx, regionCodes, &diagnostics)
+	}
+
+	if data.TaxAndCompliance != nil {
+		settings := subscription.TaxAndComplianceSettings
+		if settings == nil {
+			settings = &androidpublisher.SubscriptionTaxAndComplianceSettings{}
+		}
+		data.TaxAndCompliance.setSettings(settings)
+	}
+
+	return diagnostics
+}
+
+func (tax *subscriptionTaxModel) setSettings(settings *androidpublisher.SubscriptionTaxAndComplianceSettings) {
+	if !tax.EEAWithdrawalRightType.IsNull() {
+		tax.EEAWithdrawalRightType = types.StringValue(settings.EeaWithdrawalRightType)
+	}
+	if !tax.TokenizedDigitalAsset.IsNull() {
+		tax.TokenizedDigitalAsset = types.BoolValue(settings.IsTokenizedDigitalAsset)
+	}
+	if !tax.ProductTaxCategoryCode.IsNull() {
+		tax.ProductTaxCategoryCode = types.StringValue(settings.ProductTaxCategoryCode)
+	}
+	tax.TaxRateInfoByRegionCode = updateTaxRates(tax.TaxRateInfoByRegionCode, settings.TaxRateInfoByRegionCode)
+}
+
+// updateTaxRates refreshes the managed tax rates from rates, keeping only the
+// regions and settings which were already managed.
+func updateTaxRates(managed map[string]taxRateModel, rates map[string]androidpublisher.RegionalTaxRateInfo) map[string]taxRateModel {
+	if managed == nil {
+		return nil
+	}
+
+	updated := map[string]taxRateModel{}
+	for region, model := range managed {
+		rate, ok := rates[region]
+		if !ok {
+			continue
+		}
+		if !model.EligibleForStreamingServiceTaxRate.IsNull() {
+			model.EligibleForStreamingServiceTaxRate = types.BoolValue(rate.EligibleForStreamingServiceTaxRate)
+		}
+		if !model.StreamingTaxType.IsNull() {
+			model.StreamingTaxType = types.StringValue(rate.StreamingTaxType)
+		}
+		if !model.TaxTier.IsNull() {
+			model.TaxTier = types.StringValue(rate.TaxTier)
+		}
+		updated[region] = model
+	}
+	return updated
+}
+
+// mergeTaxRates sets the configured tax settings of each managed region on
+// a copy of current, so that other regions and settings are kept.
+func mergeTaxRates(current map[string]androidpublisher.RegionalTaxRateInfo, managed map[string]taxRateModel) map[string]androidpublisher.RegionalTaxRateInfo {
+	merged := maps.Clone(current)
+	if merged == nil {
+		merged = map[string]androidpublisher.RegionalTaxRateInfo{}
+	}
+	for region, model := range managed {
+		rate := merged[region]
+		if !model.EligibleForStreamingServiceTaxRate.IsNull() {
+			rate.EligibleForStreamingServiceTaxRate = model.EligibleForStreamingServiceTaxRate.ValueBool()
+		}
+		if !model.StreamingTaxType.IsNull() {
+			rate.StreamingTaxType = model.StreamingTaxType.ValueString()
+		}
+		if !model.TaxTier.IsNull() {
+			rate.TaxTier = model.TaxTier.ValueString()
+		}
+		merged[region] = rate
+	}
+	return merged
+}
+
+// optionalString converts an empty string returned by the API to null.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
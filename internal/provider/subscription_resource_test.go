@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testSubscription() subscriptionResourceModel {
+	return subscriptionResourceModel{
+		ID:             types.StringValue("com.example.app/premium"),
+		PackageName:    types.StringValue("com.example.app"),
+		ProductID:      types.StringValue("premium"),
+		RegionsVersion: types.StringNull(),
+		Listings: map[string]subscriptionListingModel{
+			"en-GB": {
+				Title:       types.StringValue("Premium"),
+				Description: types.StringNull(),
+				Benefits: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("No adverts"),
+				}),
+			},
+		},
+		TaxAndCompliance: &subscriptionTaxModel{
+			EEAWithdrawalRightType: types.StringValue("WITHDRAWAL_RIGHT_SERVICE"),
+			TokenizedDigitalAsset:  types.BoolNull(),
+			ProductTaxCategoryCode: types.StringNull(),
+			TaxRateInfoByRegionCode: map[string]taxRateModel{
+				"US": {
+					EligibleForStreamingServiceTaxRate: types.BoolNull(),
+					StreamingTaxType:                   types.StringValue("STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL"),
+					TaxTier:                            types.StringNull(),
+				},
+			},
+		},
+		RestrictedPaymentCountries: types.SetNull(types.StringType),
+		Archived:                   types.BoolValue(false),
+	}
+}
+
+func TestSubscriptionRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	subscription, diags := testSubscription().subscription(ctx, nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []*androidpublisher.SubscriptionListing{
+		{LanguageCode: "en-GB", Title: "Premium", Benefits: []string{"No adverts"}},
+	}, subscription.Listings)
+	assert.Nil(t, subscription.RestrictedPaymentCountries)
+	assert.Equal(t, "WITHDRAWAL_RIGHT_SERVICE", subscription.TaxAndComplianceSettings.EeaWithdrawalRightType)
+
+	// Settings chosen by Google Play are not managed
+	subscription.TaxAndComplianceSettings.ProductTaxCategoryCode = "digital_content"
+	subscription.TaxAndComplianceSettings.TaxRateInfoByRegionCode["CA"] = androidpublisher.RegionalTaxRateInfo{
+		TaxTier: "TAX_TIER_NEWS_1",
+	}
+
+	model := testSubscription()
+	assert.False(t, model.setSubscription(ctx, subscription).HasError())
+	assert.Equal(t, testSubscription(), model)
+}
+
+func TestSubscriptionRestrictedPaymentCountries(t *testing.T) {
+	ctx := context.Background()
+
+	model := testSubscription()
+	assert.False(t, model.setSubscription(ctx, &androidpublisher.Subscription{
+		RestrictedPaymentCountries: &androidpublisher.RestrictedPaymentCountries{RegionCodes: []string{"IN"}},
+	}).HasError())
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("IN")}), model.RestrictedPaymentCountries)
+	assert.Empty(t, model.Listings)
+}
+
+// testUpdateSubscription runs an update of the subscription resource from
+// data, and returns the query and body of the Patch request it sends.
+func testUpdateSubscription(t *testing.T, data subscriptionResourceModel, current string) (string, map[string]interface{}, []string) {
+	ctx := context.Background()
+
+	var mask string
+	var patched map[string]interface{}
+	client, requests := testPublisherClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			mask = r.URL.Query().Get("updateMask")
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &patched))
+			_, _ = w.Write(body)
+			return
+		}
+		_, _ = w.Write([]byte(current))
+	})
+
+	r := &SubscriptionResource{client: client}
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+
+	req := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResponse.Schema}}
+	assert.False(t, req.Plan.Set(ctx, &data).HasError())
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	r.Update(ctx, req, resp)
+	assert.Empty(t, diagnosticDetails(resp.Diagnostics))
+
+	return mask, patched, *requests
+}
+
+func TestSubscriptionUpdateOnlySendsConfiguredSettings(t *testing.T) {
+	data := testSubscription()
+	data.TaxAndCompliance = nil
+
+	mask, patched, requests := testUpdateSubscription(t, data, "")
+	assert.Equal(t, "listings", mask)
+	assert.NotContains(t, patched, "taxAndComplianceSettings")
+	assert.NotContains(t, patched, "restrictedPaymentCountries")
+	assert.Equal(t, []string{"PATCH /subscriptions/premium"}, requests)
+}
+
+func TestSubscriptionUpdateKeepsUnmanagedTaxSettings(t *testing.T) {
+	mask, patched, requests := testUpdateSubscription(t, testSubscription(), `{
+		"productId": "premium",
+		"taxAndComplianceSettings": {
+			"productTaxCategoryCode": "digital_content",
+			"isTokenizedDigitalAsset": true,
+			"taxRateInfoByRegionCode": {
+				"CA": {"taxTier": "TAX_TIER_NEWS_1"},
+				"US": {"taxTier": "TAX_TIER_NEWS_5"}
+			}
+		}
+	}`)
+
+	assert.Equal(t, "listings,taxAndComplianceSettings.eeaWithdrawalRightType,taxAndComplianceSettings.taxRateInfoByRegionCode", mask)
+	assert.Equal(t, map[string]interface{}{
+		"eeaWithdrawalRightType": "WITHDRAWAL_RIGHT_SERVICE",
+		"taxRateInfoByRegionCode": map[string]interface{}{
+			"CA": map[string]interface{}{"taxTier": "TAX_TIER_NEWS_1"},
+			"US": map[string]interface{}{
+				"streamingTaxType": "STREAMING_TAX_TYPE_TELCO_VIDEO_RENTAL",
+				"taxTier":          "TAX_TIER_NEWS_5",
+			},
+		},
+	}, patched["taxAndComplianceSettings"])
+	assert.Equal(t, []string{
+		"GET /subscriptions/premium",
+		"PATCH /subscriptions/premium",
+	}, requests)
+}
+
+func TestSubscriptionUpdateSendsConfiguredFalse(t *testing.T) {
+	data := testSubscription()
+	data.TaxAndCompliance.TokenizedDigitalAsset = types.BoolValue(false)
+	data.TaxAndCompliance.TaxRateInfoByRegionCode = nil
+	data.RestrictedPaymentCountries = types.SetValueMust(types.StringType, []attr.Value{})
+
+	mask, patched, _ := testUpdateSubscription(t, data, "")
+	assert.Equal(t, "listings,restrictedPaymentCountries,taxAndComplianceSettings.eeaWithdrawalRightType,taxAndComplianceSettings.isTokenizedDigitalAsset", mask)
+	assert.Equal(t, false, patched["taxAndComplianceSettings"].(map[string]interface{})["isTokenizedDigitalAsset"])
+}
@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ datasource.DataSource = &SubscriptionsDataSource{}
+
+func NewSubscriptionsDataSource() datasource.DataSource {
+	return &SubscriptionsDataSource{}
+}
+
+type SubscriptionsDataSource struct {
+	client *GooglePlayClient
+}
+
+type subscriptionsDataSourceModel struct {
+	PackageName   types.String                        `tfsdk:"package_name"`
+	ShowArchived  types.Bool                          `tfsdk:"show_archived"`
+	ActiveOnly    types.Bool                          `tfsdk:"active_only"`
+	Tags          types.Set                           `tfsdk:"tags"`
+	ProductIDs    []types.String                      `tfsdk:"product_ids"`
+	Subscriptions map[string]subscriptionCatalogModel `tfsdk:"subscriptions"`
+}
+
+type subscriptionCatalogModel struct {
+	Archived  types.Bool                      `tfsdk:"archived"`
+	BasePlans map[string]basePlanCatalogModel `tfsdk:"base_plans"`
+}
+
+type basePlanCatalogModel struct {
+	State          types.String                 `tfsdk:"state"`
+	Type           types.String                 `tfsdk:"type"`
+	BillingPeriod  types.String                 `tfsdk:"billing_period"`
+	OfferTags      types.Set                    `tfsdk:"offer_tags"`
+	RegionalPrices map[string]moneyModel        `tfsdk:"regional_prices"`
+	Offers         map[string]offerCatalogModel `tfsdk:"offers"`
+}
+
+type offerCatalogModel struct {
+	State     types.String             `tfsdk:"state"`
+	OfferTags types.Set                `tfsdk:"offer_tags"`
+	Phases    []offerPhaseCatalogModel `tfsdk:"phases"`
+}
+
+type offerPhaseCatalogModel struct {
+	Duration        types.String                    `tfsdk:"duration"`
+	RecurrenceCount types.Int64                     `tfsdk:"recurrence_count"`
+	RegionalConfigs map[string]offerPhasePriceModel `tfsdk:"regional_configs"`
+}
+
+func (d *SubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+// moneyDataSourceAttribute describes an amount of money read by a data source.
+func moneyDataSourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"amount": schema.StringAttribute{
+				MarkdownDescription: "The amount and its currency, for example `9.99 USD`",
+				CustomType:          moneyAmountType{},
+				Computed:            true,
+			},
+			"units": schema.Int64Attribute{
+				MarkdownDescription: "The whole units of the amount, for example `9` for 9.99",
+				Computed:            true,
+			},
+			"nanos": schema.Int64Attribute{
+				MarkdownDescription: "The billionths of a unit in the amount, for example `990000000` for 9.99",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The ISO 4217 currency code, for example `USD`",
+				Computed:            true,
+			},
+		},
+		Computed: true,
+	}
+}
+
+func (d *SubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Fetch the subscriptions of an app, with their base plans and offers.
+		Subscriptions can be filtered by state and offer tag`,
+
+		Attributes: map[string]schema.Attribute{
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+			},
+			"show_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include archived subscriptions. Defaults to `false`",
+				Optional:            true,
+			},
+			"active_only": schema.BoolAttribute{
+				MarkdownDescription: "Only include active base plans and offers, and the subscriptions with at least one active base plan. Defaults to `false`",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only include subscriptions with a base plan or offer that has at least one of these offer tags",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"product_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the subscriptions, in alphabetical order",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"subscriptions": schema.MapNestedAttribute{
+				MarkdownDescription: "The subscriptions, keyed by product ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"archived": schema.BoolAttribute{
+							MarkdownDescription: "Whether the subscription is archived",
+							Computed:            true,
+						},
+						"base_plans": schema.MapNestedAttribute{
+							MarkdownDescription: "The base plans of the subscription, keyed by base plan ID",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"state": schema.StringAttribute{
+										MarkdownDescription: "The state of the base plan, one of: " + strings.Join(basePlanStates, ", "),
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "How the base plan renews, one of: `auto_renewing`, `prepaid`, `installments`",
+										Computed:            true,
+									},
+									"billing_period": schema.StringAttribute{
+										MarkdownDescription: "The ISO 8601 duration of each billing period, for example `P1M`",
+										Computed:            true,
+									},
+									"offer_tags": schema.SetAttribute{
+										MarkdownDescription: "The offer tags of the base plan",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+									"regional_prices": schema.MapNestedAttribute{
+										MarkdownDescription: "The price of the base plan, keyed by region code",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: moneyDataSourceAttribute("").Attributes,
+										},
+										Computed: true,
+									},
+									"offers": schema.MapNestedAttribute{
+										MarkdownDescription: "The offers of the base plan, keyed by offer ID",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"state": schema.StringAttribute{
+													MarkdownDescription: "The state of the offer, one of: " + strings.Join(basePlanStates, ", "),
+													Computed:            true,
+												},
+												"offer_tags": schema.SetAttribute{
+													MarkdownDescription: "The offer tags of the offer",
+													ElementType:         types.StringType,
+													Computed:            true,
+												},
+												"phases": schema.ListNestedAttribute{
+													MarkdownDescription: "The phases of the offer, in order",
+													NestedObject: schema.NestedAttributeObject{
+														Attributes: map[string]schema.Attribute{
+															"duration": schema.StringAttribute{
+																MarkdownDescription: "The ISO 8601 duration of each recurrence of the phase",
+																Computed:            true,
+															},
+															"recurrence_count": schema.Int64Attribute{
+																MarkdownDescription: "The number of times the phase repeats",
+																Computed:            true,
+															},
+															"regional_configs": schema.MapNestedAttribute{
+																MarkdownDescription: "The price of the phase, keyed by region code. Each region has one of `price`, `absolute_discount`, `relative_discount` or `free`",
+																NestedObject: schema.NestedAttributeObject{
+																	Attributes: map[string]schema.Attribute{
+																		"price":             moneyDataSourceAttribute("The price of the phase"),
+																		"absolute_discount": moneyDataSourceAttribute("The amount taken off the base plan price"),
+																		"relative_discount": schema.Float64Attribute{
+																			MarkdownDescription: "The fraction taken off the base plan price",
+																			Computed:            true,
+																		},
+																		"free": schema.BoolAttribute{
+																			MarkdownDescription: "Whether the phase is a free trial",
+																			Computed:            true,
+																		},
+																	},
+																},
+																Computed: true,
+															},
+														},
+													},
+													Computed: true,
+												},
+											},
+										},
+										Computed: true,
+									},
+								},
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *SubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data subscriptionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	subscriptions, err := d.client.ListSubscriptions(ctx, packageName, data.ShowArchived.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch subscriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	offers, err := d.client.ListSubscriptionOffers(ctx, packageName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch subscription offers",
+			err.Error(),
+		)
+		return
+	}
+
+	tags := []string{}
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Subscriptions = subscriptionCatalog(ctx, subscriptions, offers, &resp.Diagnostics)
+	data.filter(data.ActiveOnly.ValueBool(), tags)
+
+	data.ProductIDs = []types.String{}
+	for _, productID := range slices.Sorted(maps.Keys(data.Subscriptions)) {
+		data.ProductIDs = append(data.ProductIDs, types.StringValue(productID))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// subscriptionCatalog returns subscriptions keyed by product ID, with their
+// base plans and offers.
+func subscriptionCatalog(
+	ctx context.Context,
+	subscriptions []*androidpublisher.Subscription,
+	offers []*androidpublisher.SubscriptionOffer,
+	diagnostics *diag.Diagnostics,
+) map[string]subscriptionCatalogModel {
+	catalog := map[string]subscriptionCatalogModel{}
+	for _, subscription := range subscriptions {
+		basePlans := map[string]basePlanCatalogModel{}
+		for _, basePlan := range subscription.BasePlans {
+			basePlans[basePlan.BasePlanId] = basePlanCatalogValue(ctx, basePlan, diagnostics)
+		}
+		catalog[subscription.ProductId] = subscriptionCatalogModel{
+			Archived:  types.BoolValue(subscription.Archived),
+			BasePlans: basePlans,
+		}
+	}
+
+	for _, offer := range offers {
+		basePlan, ok := catalog[offer.ProductId].BasePlans[offer.BasePlanId]
+		if !ok {
+			// The offer belongs to an archived subscription which was not listed
+			continue
+		}
+		basePlan.Offers[offer.OfferId] = offerCatalogValue(ctx, offer, diagnostics)
+	}
+
+	return catalog
+}
+
+func basePlanCatalogValue(ctx context.Context, basePlan *androidpublisher.BasePlan, diagnostics *diag.Diagnostics) basePlanCatalogModel {
+	model := basePlanCatalogModel{
+		State:          types.StringValue(basePlanState(basePlan.State)),
+		Type:           types.StringNull(),
+		BillingPeriod:  types.StringNull(),
+		OfferTags:      stringSetValue(ctx, offerTagNames(basePlan.OfferTags), diagnostics),
+		RegionalPrices: map[string]moneyModel{},
+		Offers:         map[string]offerCatalogModel{},
+	}
+
+	switch {
+	case basePlan.AutoRenewingBasePlanType != nil:
+		model.Type = types.StringValue("auto_renewing")
+		model.BillingPeriod = types.StringValue(basePlan.AutoRenewingBasePlanType.BillingPeriodDuration)
+	case basePlan.PrepaidBasePlanType != nil:
+		model.Type = types.StringValue("prepaid")
+		model.BillingPeriod = types.StringValue(basePlan.PrepaidBasePlanType.BillingPeriodDuration)
+	case basePlan.InstallmentsBasePlanType != nil:
+		model.Type = types.StringValue("installments")
+		model.BillingPeriod = types.StringValue(basePlan.InstallmentsBasePlanType.BillingPeriodDuration)
+	}
+
+	for _, config := range basePlan.RegionalConfigs {
+		if config.Price != nil {
+			model.RegionalPrices[config.RegionCode] = moneyValue(config.Price)
+		}
+	}
+
+	return model
+}
+
+func offerCatalogValue(ctx context.Context, offer *androidpublisher.SubscriptionOffer, diagnostics *diag.Diagnostics) offerCatalogModel {
+	model := offerCatalogModel{
+		State:     types.StringValue(basePlanState(offer.State)),
+		OfferTags: stringSetValue(ctx, offerTagNames(offer.OfferTags), diagnostics),
+		Phases:    []offerPhaseCatalogModel{},
+	}
+
+	for _, offerPhase := range offer.Phases {
+		phase := offerPhaseCatalogModel{
+			Duration:        types.StringValue(offerPhase.Duration),
+			RecurrenceCount: types.Int64Value(offerPhase.RecurrenceCount),
+			RegionalConfigs: map[string]offerPhasePriceModel{},
+		}
+		for _, regional := range offerPhase.RegionalConfigs {
+			phase.RegionalConfigs[regional.RegionCode] = offerPhasePriceModel{
+				Price:            moneyPointer(regional.Price),
+				AbsoluteDiscount: moneyPointer(regional.AbsoluteDiscount),
+				RelativeDiscount: optionalFloat64(regional.RelativeDiscount),
+				Free:             types.BoolValue(regional.Free != nil),
+			}
+		}
+		model.Phases = append(model.Phases, phase)
+	}
+
+	return model
+}
+
+// filter removes base plans and offers which are not active if activeOnly is
+// set, then subscriptions without any base plan or offer tagged with one of
+// tags, if there are any tags.
+func (data *subscriptionsDataSourceModel) filter(activeOnly bool, tags []string) {
+	for productID, subscription := range data.Subscriptions {
+		if activeOnly {
+			maps.DeleteFunc(subscription.BasePlans, func(_ string, basePlan basePlanCatalogModel) bool {
+				return basePlan.State.ValueString() != "active"
+			})
+			for _, basePlan := range subscription.BasePlans {
+				maps.DeleteFunc(basePlan.Offers, func(_ string, offer offerCatalogModel) bool {
+					return offer.State.ValueString() != "active"
+				})
+			}
+			if len(subscription.BasePlans) == 0 {
+				delete(data.Subscriptions, productID)
+				continue
+			}
+		}
+
+		if len(tags) > 0 && !subscription.hasTag(tags) {
+			delete(data.Subscriptions, productID)
+		}
+	}
+}
+
+func (m subscriptionCatalogModel) hasTag(tags []string) bool {
+	tagged := func(set types.Set) bool {
+		for _, element := range set.Elements() {
+			if tag, ok := element.(types.String); ok && slices.Contains(tags, tag.ValueString()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, basePlan := range m.BasePlans {
+		if tagged(basePlan.OfferTags) {
+			return true
+		}
+		for _, offer := range basePlan.Offers {
+			if tagged(offer.OfferTags) {
+				return true
+			}
+		}
+	}
+	return false
+}
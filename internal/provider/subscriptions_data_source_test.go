@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+func testSubscriptionCatalog(t *testing.T) map[string]subscriptionCatalogModel {
+	var diags diag.Diagnostics
+	catalog := subscriptionCatalog(context.Background(), []*androidpublisher.Subscription{
+		{
+			ProductId: "premium",
+			BasePlans: []*androidpublisher.BasePlan{
+				{
+					BasePlanId:               "monthly",
+					State:                    "ACTIVE",
+					AutoRenewingBasePlanType: &androidpublisher.AutoRenewingBasePlanType{BillingPeriodDuration: "P1M"},
+					RegionalConfigs: []*androidpublisher.RegionalBasePlanConfig{
+						{RegionCode: "GB", Price: &androidpublisher.Money{Units: 4, Nanos: 990000000, CurrencyCode: "GBP"}},
+					},
+				},
+				{
+					BasePlanId:          "pass",
+					State:               "DRAFT",
+					PrepaidBasePlanType: &androidpublisher.PrepaidBasePlanType{BillingPeriodDuration: "P1W"},
+					OfferTags:           []*androidpublisher.OfferTag{{Tag: "pass"}},
+				},
+			},
+		},
+		{
+			ProductId: "basic",
+			Archived:  true,
+			BasePlans: []*androidpublisher.BasePlan{{BasePlanId: "monthly", State: "INACTIVE"}},
+		},
+	}, []*androidpublisher.SubscriptionOffer{
+		{
+			ProductId:  "premium",
+			BasePlanId: "monthly",
+			OfferId:    "trial",
+			State:      "ACTIVE",
+			OfferTags:  []*androidpublisher.OfferTag{{Tag: "intro"}},
+			Phases: []*androidpublisher.SubscriptionOfferPhase{{
+				Duration:        "P1W",
+				RecurrenceCount: 1,
+				RegionalConfigs: []*androidpublisher.RegionalSubscriptionOfferPhaseConfig{
+					{RegionCode: "GB", Free: &androidpublisher.RegionalSubscriptionOfferPhaseFreePriceOverride{}},
+				},
+			}},
+		},
+		{ProductId: "archived", BasePlanId: "monthly", OfferId: "trial"},
+	}, &diags)
+	assert.False(t, diags.HasError())
+	return catalog
+}
+
+func TestSubscriptionCatalog(t *testing.T) {
+	catalog := testSubscriptionCatalog(t)
+
+	monthly := catalog["premium"].BasePlans["monthly"]
+	assert.Equal(t, "active", monthly.State.ValueString())
+	assert.Equal(t, "auto_renewing", monthly.Type.ValueString())
+	assert.Equal(t, "P1M", monthly.BillingPeriod.ValueString())
+	assert.Equal(t, "4.99 GBP", monthly.RegionalPrices["GB"].Amount.ValueString())
+	assert.True(t, monthly.Offers["trial"].Phases[0].RegionalConfigs["GB"].Free.ValueBool())
+	assert.Equal(t, "prepaid", catalog["premium"].BasePlans["pass"].Type.ValueString())
+	assert.Equal(t, "inactive", catalog["basic"].BasePlans["monthly"].State.ValueString())
+	assert.True(t, catalog["basic"].Archived.ValueBool())
+}
+
+func TestSubscriptionCatalogFilter(t *testing.T) {
+	data := subscriptionsDataSourceModel{Subscriptions: testSubscriptionCatalog(t)}
+	data.filter(true, nil)
+	assert.Equal(t, []string{"premium"}, slices.Sorted(maps.Keys(data.Subscriptions)))
+	assert.Equal(t, []string{"monthly"}, slices.Sorted(maps.Keys(data.Subscriptions["premium"].BasePlans)))
+
+	data = subscriptionsDataSourceModel{Subscriptions: testSubscriptionCatalog(t)}
+	data.filter(false, []string{"intro"})
+	assert.Equal(t, []string{"premium"}, slices.Sorted(maps.Keys(data.Subscriptions)))
+
+	data = subscriptionsDataSourceModel{Subscriptions: testSubscriptionCatalog(t)}
+	data.filter(true, []string{"pass"})
+	assert.Empty(t, data.Subscriptions)
+}
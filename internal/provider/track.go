@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"slices"
+	"strings"
+)
+
+// builtInTracks are the tracks that every app has. Form factor tracks have
+// the same built-in tracks, with a prefix such as `wear:`.
+var builtInTracks = []string{"production", "beta", "alpha", "internal"}
+
+// formFactorPrefixes maps the prefix of a form factor track to its form factor.
+var formFactorPrefixes = map[string]string{
+	"wear":       "WEAR",
+	"automotive": "AUTOMOTIVE",
+}
+
+const defaultFormFactor = "DEFAULT"
+
+// splitTrack returns the form factor of a track and its name without the
+// form factor prefix.
+func splitTrack(track string) (string, string) {
+	prefix, name, found := strings.Cut(track, ":")
+	if !found {
+		return defaultFormFactor, track
+	}
+	formFactor, ok := formFactorPrefixes[prefix]
+	if !ok {
+		return defaultFormFactor, track
+	}
+	return formFactor, name
+}
+
+// isBuiltInTrack reports whether track is one that Google Play creates for
+// every app, such as `production` or `wear:internal`.
+func isBuiltInTrack(track string) bool {
+	_, name := splitTrack(track)
+	return slices.Contains(builtInTracks, name)
+}
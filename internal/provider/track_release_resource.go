@@ -0,0 +1,694 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &TrackReleaseResource{}
+var _ resource.ResourceWithValidateConfig = &TrackReleaseResource{}
+var _ resource.ResourceWithModifyPlan = &TrackReleaseResource{}
+
+func NewTrackReleaseResource() resource.Resource {
+	return &TrackReleaseResource{}
+}
+
+type TrackReleaseResource struct {
+	client *GooglePlayClient
+}
+
+type trackReleaseResourceModel struct {
+	ID              types.String  `tfsdk:"id"`
+	PackageName     types.String  `tfsdk:"package_name"`
+	Track           types.String  `tfsdk:"track"`
+	VersionCodes    types.Set     `tfsdk:"version_codes"`
+	Status          types.String  `tfsdk:"status"`
+	UserFraction    types.Float64 `tfsdk:"user_fraction"`
+	ReleaseNotes    types.Map     `tfsdk:"release_notes"`
+	Name            types.String  `tfsdk:"name"`
+	RolloutSchedule types.Object  `tfsdk:"rollout_schedule"`
+
+	AllowProductionChanges  types.Bool `tfsdk:"allow_production_changes"`
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+type rolloutScheduleModel struct {
+	Steps           types.List    `tfsdk:"steps"`
+	MinSoakTime     types.String  `tfsdk:"min_soak_time"`
+	HaltOnCrashRate types.Float64 `tfsdk:"halt_on_crash_rate"`
+	CrashRate       types.Float64 `tfsdk:"crash_rate"`
+	CurrentStep     types.Int64   `tfsdk:"current_step"`
+	StepStartedAt   types.String  `tfsdk:"step_started_at"`
+}
+
+var rolloutScheduleAttrTypes = map[string]attr.Type{
+	"steps":              types.ListType{ElemType: types.Float64Type},
+	"min_soak_time":      types.StringType,
+	"halt_on_crash_rate": types.Float64Type,
+	"crash_rate":         types.Float64Type,
+	"current_step":       types.Int64Type,
+	"step_started_at":    types.StringType,
+}
+
+func (r *TrackReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_track_release"
+}
+
+func (r *TrackReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a release on a Google Play track, including staged rollouts",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the release, in the format `package_name/track/version_codes`, with the version codes in ascending order and separated by commas",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"track": schema.StringAttribute{
+				MarkdownDescription: "The track to release to, for example `internal`, `alpha`, `beta` or `production`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_codes": schema.SetAttribute{
+				MarkdownDescription: "Version codes of the app bundles or APKs in the release",
+				ElementType:         types.Int64Type,
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: `The status of the release, one of: ` + "`draft`, `inProgress`, `halted`, `completed`" + `:
+				https://developers.google.com/android-publisher/api-ref/rest/v3/edits.tracks#status.
+				Required unless ` + "`rollout_schedule`" + ` is set, in which case it is managed by the schedule`,
+				Optional: true,
+				Computed: true,
+			},
+			"user_fraction": schema.Float64Attribute{
+				MarkdownDescription: "Fraction of users who receive a staged rollout, between 0 and 1. Required when `status` is `inProgress`, and managed by `rollout_schedule` when it is set",
+				Optional:            true,
+				Computed:            true,
+			},
+			"release_notes": schema.MapAttribute{
+				MarkdownDescription: "What's new in this release, keyed by BCP-47 language tag, for example `en-GB`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the release. Google Play generates one from the version name if it is not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_production_changes": schema.BoolAttribute{
+				MarkdownDescription: "Must be `true` to create or change a release on one of the provider's `protected_tracks`, which default to `production`",
+				Optional:            true,
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"rollout_schedule": schema.SingleNestedBlock{
+				MarkdownDescription: `Increases the rollout one step per apply, once the current step has been live for at least ` + "`min_soak_time`" + `.
+				The rollout is halted while ` + "`crash_rate`" + ` is above ` + "`halt_on_crash_rate`" + `, and resumes once it recovers`,
+				Attributes: map[string]schema.Attribute{
+					"steps": schema.ListAttribute{
+						MarkdownDescription: "Increasing user fractions to roll out to, for example `[0.01, 0.05, 0.2, 1]`. A step of `1` completes the release",
+						ElementType:         types.Float64Type,
+						Optional:            true,
+					},
+					"min_soak_time": schema.StringAttribute{
+						MarkdownDescription: "The minimum time to wait between steps, as a duration such as `24h`",
+						Optional:            true,
+					},
+					"halt_on_crash_rate": schema.Float64Attribute{
+						MarkdownDescription: "Halt the rollout while `crash_rate` is above this threshold",
+						Optional:            true,
+					},
+					"crash_rate": schema.Float64Attribute{
+						MarkdownDescription: "The current crash rate of the release, usually from the `googleplay_crash_rate` data source",
+						Optional:            true,
+					},
+					"current_step": schema.Int64Attribute{
+						MarkdownDescription: "The index of the step the rollout has reached",
+						Computed:            true,
+					},
+					"step_started_at": schema.StringAttribute{
+						MarkdownDescription: "When the rollout reached the current step, in RFC 3339 format",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TrackReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrackReleaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data trackReleaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.VersionCodes.IsUnknown() && !data.VersionCodes.IsNull() && len(data.VersionCodes.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version_codes"),
+			"Invalid version codes",
+			"version_codes must contain at least one version code.",
+		)
+	}
+
+	if !data.RolloutSchedule.IsNull() {
+		resp.Diagnostics.Append(data.validateRolloutSchedule(ctx)...)
+		return
+	}
+
+	if data.Status.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Missing release status",
+			"status is required unless the release has a rollout_schedule.",
+		)
+		return
+	}
+
+	if data.Status.IsUnknown() || data.UserFraction.IsUnknown() {
+		return
+	}
+
+	status := ReleaseStatus(data.Status.ValueString())
+	if !slices.Contains(releaseStatuses, status) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid release status",
+			fmt.Sprintf(
+				"'%s' is not a valid release status, expected one of: %s, %s, %s, %s.",
+				status, ReleaseDraft, ReleaseInProgress, ReleaseHalted, ReleaseCompleted,
+			),
+		)
+		return
+	}
+
+	// A staged rollout only makes sense while the release is in progress
+	if err := status.ValidateUserFraction(data.UserFraction.ValueFloat64Pointer()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_fraction"),
+			"Invalid user fraction",
+			err.Error(),
+		)
+	}
+}
+
+func (data *trackReleaseResourceModel) validateRolloutSchedule(ctx context.Context) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if !data.Status.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Conflicting release status",
+			"status cannot be set alongside rollout_schedule, which manages the status of the release.",
+		)
+	}
+	if !data.UserFraction.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("user_fraction"),
+			"Conflicting user fraction",
+			"user_fraction cannot be set alongside rollout_schedule, which manages the user fraction of the release.",
+		)
+	}
+
+	if data.RolloutSchedule.IsUnknown() {
+		return diagnostics
+	}
+
+	var model rolloutScheduleModel
+	diagnostics.Append(data.RolloutSchedule.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	if model.Steps.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("rollout_schedule").AtName("steps"),
+			"Missing rollout steps",
+			"rollout_schedule must list the user fractions to roll out to in steps.",
+		)
+	}
+	if model.MinSoakTime.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("rollout_schedule").AtName("min_soak_time"),
+			"Missing soak time",
+			"rollout_schedule must set min_soak_time, the time to wait between steps.",
+		)
+	}
+	if !model.HaltOnCrashRate.IsNull() && model.CrashRate.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root("rollout_schedule").AtName("crash_rate"),
+			"Missing crash rate",
+			"crash_rate is required to check halt_on_crash_rate, for example from the googleplay_crash_rate data source.",
+		)
+	}
+	if diagnostics.HasError() || !model.known() {
+		return diagnostics
+	}
+
+	schedule, diags := model.schedule(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+	if err := schedule.Validate(); err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("rollout_schedule"),
+			"Invalid rollout schedule",
+			err.Error(),
+		)
+	}
+	return diagnostics
+}
+
+func (r *TrackReleaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the release is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planRollout(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.guardProtectedTrack(ctx, req, resp)
+}
+
+// planRollout plans the status and user fraction of the release, following
+// the rollout schedule when there is one.
+func (r *TrackReleaseResource) planRollout(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, config trackReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a schedule, the status and user fraction are exactly as configured
+	if plan.RolloutSchedule.IsNull() {
+		plan.Status = config.Status
+		plan.UserFraction = config.UserFraction
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var model rolloutScheduleModel
+	resp.Diagnostics.Append(plan.RolloutSchedule.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || !model.known() {
+		return
+	}
+
+	schedule, diags := model.schedule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || schedule.Validate() != nil {
+		return
+	}
+
+	// Start a new rollout at the first step, and advance an existing one when it is due
+	step := 0
+	model.StepStartedAt = types.StringUnknown()
+	if !req.State.Raw.IsNull() {
+		var state trackReleaseResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Take over an existing rollout without reducing it
+		step = schedule.StartingStep(state.UserFraction.ValueFloat64Pointer())
+		if ReleaseStatus(state.Status.ValueString()) == ReleaseCompleted {
+			step = len(schedule.steps) - 1
+		}
+		if !state.RolloutSchedule.IsNull() {
+			var prior rolloutScheduleModel
+			resp.Diagnostics.Append(state.RolloutSchedule.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			startedAt, err := time.Parse(time.RFC3339, prior.StepStartedAt.ValueString())
+			if err == nil && !prior.CurrentStep.IsNull() {
+				current := min(int(prior.CurrentStep.ValueInt64()), len(schedule.steps)-1)
+				step = schedule.NextStep(current, startedAt, time.Now())
+				if step == int(prior.CurrentStep.ValueInt64()) {
+					model.StepStartedAt = prior.StepStartedAt
+				}
+			}
+		}
+	}
+
+	status, fraction := schedule.Release(step)
+	if status == ReleaseHalted {
+		resp.Diagnostics.AddWarning(
+			"Rollout halted",
+			fmt.Sprintf(
+				"The crash rate of %g is above the halt_on_crash_rate threshold of %g, so the rollout on the %s track will be halted at %g.",
+				*schedule.crashRate, *schedule.haltOnCrashRate, plan.Track.ValueString(), *fraction,
+			),
+		)
+	}
+
+	model.CurrentStep = types.Int64Value(int64(step))
+	plan.Status = types.StringValue(string(status))
+	plan.UserFraction = types.Float64PointerValue(fraction)
+
+	var diag diag.Diagnostics
+	plan.RolloutSchedule, diag = types.ObjectValueFrom(ctx, rolloutScheduleAttrTypes, model)
+	resp.Diagnostics.Append(diag...)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// known reports whether every configured value of the schedule is known.
+func (model rolloutScheduleModel) known() bool {
+	return !model.Steps.IsUnknown() &&
+		!model.MinSoakTime.IsUnknown() &&
+		!model.HaltOnCrashRate.IsUnknown() &&
+		!model.CrashRate.IsUnknown()
+}
+
+func (model rolloutScheduleModel) schedule(ctx context.Context) (rolloutSchedule, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	schedule := rolloutSchedule{
+		haltOnCrashRate: model.HaltOnCrashRate.ValueFloat64Pointer(),
+		crashRate:       model.CrashRate.ValueFloat64Pointer(),
+	}
+	diagnostics.Append(model.Steps.ElementsAs(ctx, &schedule.steps, false)...)
+
+	minSoakTime, err := time.ParseDuration(model.MinSoakTime.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("rollout_schedule").AtName("min_soak_time"),
+			"Invalid soak time",
+			fmt.Sprintf("min_soak_time must be a duration such as 24h: %s", err),
+		)
+	}
+	schedule.minSoakTime = minSoakTime
+
+	return schedule, diagnostics
+}
+
+func (r *TrackReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data trackReleaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateRelease(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data trackReleaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionCodes := []int64{}
+	resp.Diagnostics.Append(data.VersionCodes.ElementsAs(ctx, &versionCodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var track *androidpublisher.Track
+	err := r.client.ReadEdit(ctx, packageName, func(editID string) error {
+		var err error
+		track, err = r.client.GetTrack(ctx, packageName, editID, data.Track.ValueString())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch track",
+			err.Error(),
+		)
+		return
+	}
+
+	// The release has been superseded or removed outside of Terraform
+	release := findTrackRelease(track.Releases, versionCodes)
+	if release == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(trackReleaseID(packageName, data.Track.ValueString(), versionCodes))
+	resp.Diagnostics.Append(data.setRelease(ctx, release)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data trackReleaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateRelease(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data trackReleaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Releases which have reached users cannot be withdrawn, only superseded
+	if ReleaseStatus(data.Status.ValueString()) != ReleaseDraft {
+		resp.Diagnostics.AddWarning(
+			"Release left in place",
+			fmt.Sprintf(
+				"The %s release on the %s track has been removed from Terraform state, but it remains on Google Play. Halt the release or publish a new one to stop serving it.",
+				data.Status.ValueString(), data.Track.ValueString(),
+			),
+		)
+		return
+	}
+
+	versionCodes := []int64{}
+	resp.Diagnostics.Append(data.VersionCodes.ElementsAs(ctx, &versionCodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	_, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		return r.client.RemoveRelease(ctx, packageName, editID, data.Track.ValueString(), versionCodes)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete release, got error: %s", err))
+		return
+	}
+}
+
+// updateRelease writes the release in data to its track and records the result.
+func (r *TrackReleaseResource) updateRelease(ctx context.Context, data *trackReleaseResourceModel, diagnostics *diag.Diagnostics) {
+	release, diags := data.release(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	track := data.Track.ValueString()
+
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		var err error
+		release, err = r.client.UpdateRelease(ctx, packageName, editID, track, release)
+		return err
+	})
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to update release",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(trackReleaseID(packageName, track, release.VersionCodes))
+	data.SentForReview = types.BoolValue(sentForReview)
+	diagnostics.Append(data.setRelease(ctx, release)...)
+
+	// Start timing the soak of a new rollout step
+	if !data.RolloutSchedule.IsNull() {
+		var model rolloutScheduleModel
+		diagnostics.Append(data.RolloutSchedule.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if model.StepStartedAt.IsUnknown() {
+			model.StepStartedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		}
+
+		var diag diag.Diagnostics
+		data.RolloutSchedule, diag = types.ObjectValueFrom(ctx, rolloutScheduleAttrTypes, model)
+		diagnostics.Append(diag...)
+	}
+}
+
+// release builds the API representation of the planned release.
+func (data *trackReleaseResourceModel) release(ctx context.Context) (*androidpublisher.TrackRelease, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	versionCodes := []int64{}
+	diagnostics.Append(data.VersionCodes.ElementsAs(ctx, &versionCodes, false)...)
+	releaseNotes := map[string]string{}
+	diagnostics.Append(data.ReleaseNotes.ElementsAs(ctx, &releaseNotes, false)...)
+
+	release := &androidpublisher.TrackRelease{
+		Name:         data.Name.ValueString(),
+		Status:       data.Status.ValueString(),
+		UserFraction: data.UserFraction.ValueFloat64(),
+		VersionCodes: versionCodes,
+	}
+
+	// Sort by language so the request is stable between applies
+	languages := make([]string, 0, len(releaseNotes))
+	for language := range releaseNotes {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	for _, language := range languages {
+		release.ReleaseNotes = append(release.ReleaseNotes, &androidpublisher.LocalizedText{
+			Language: language,
+			Text:     releaseNotes[language],
+		})
+	}
+
+	return release, diagnostics
+}
+
+// setRelease records the release returned by Google Play in data.
+func (data *trackReleaseResourceModel) setRelease(ctx context.Context, release *androidpublisher.TrackRelease) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	data.Name = types.StringValue(release.Name)
+	data.Status = types.StringValue(release.Status)
+
+	// Halted releases keep their fraction, which only matters if it was configured
+	status := ReleaseStatus(release.Status)
+	if release.UserFraction != 0 && (status == ReleaseInProgress || (status == ReleaseHalted && !data.UserFraction.IsNull())) {
+		data.UserFraction = types.Float64Value(release.UserFraction)
+	} else {
+		data.UserFraction = types.Float64Null()
+	}
+
+	var diag diag.Diagnostics
+	data.VersionCodes, diag = types.SetValueFrom(ctx, types.Int64Type, []int64(release.VersionCodes))
+	diagnostics.Append(diag...)
+
+	// Leave release notes unset if they were never configured
+	if len(release.ReleaseNotes) > 0 || !data.ReleaseNotes.IsNull() {
+		releaseNotes := map[string]string{}
+		for _, note := range release.ReleaseNotes {
+			releaseNotes[note.Language] = note.Text
+		}
+		data.ReleaseNotes, diag = types.MapValueFrom(ctx, types.StringType, releaseNotes)
+		diagnostics.Append(diag...)
+	}
+
+	return diagnostics
+}
+
+// trackReleaseID identifies a release by its version codes as well as its
+// track, since a track can hold several releases at once, such as a draft
+// alongside the live release.
+func trackReleaseID(packageName string, track string, versionCodes []int64) string {
+	sorted := slices.Sorted(slices.Values(versionCodes))
+	codes := make([]string, len(sorted))
+	for i, versionCode := range sorted {
+		codes[i] = strconv.FormatInt(versionCode, 10)
+	}
+	return fmt.Sprintf("%s/%s/%s", packageName, track, strings.Join(codes, ","))
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	androidpublisher "google.golang.org/api/androidpublisher/v3"
+)
+
+var _ resource.Resource = &TrackResource{}
+var _ resource.ResourceWithImportState = &TrackResource{}
+var _ resource.ResourceWithModifyPlan = &TrackResource{}
+
+func NewTrackResource() resource.Resource {
+	return &TrackResource{}
+}
+
+type TrackResource struct {
+	client *GooglePlayClient
+}
+
+type trackResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PackageName types.String `tfsdk:"package_name"`
+	Track       types.String `tfsdk:"track"`
+	FormFactor  types.String `tfsdk:"form_factor"`
+	BuiltIn     types.Bool   `tfsdk:"built_in"`
+
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+func (r *TrackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_track"
+}
+
+func (r *TrackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Create a custom closed testing track.
+		Built-in tracks such as ` + "`production`" + ` and ` + "`internal`" + ` can be imported, but not created or destroyed.
+		Google Play does not allow tracks to be deleted, so destroying a custom track only removes it from Terraform state`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the track, in the format `package_name/track`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"track": schema.StringAttribute{
+				MarkdownDescription: `The name of the track, for example ` + "`qa`" + `.
+				Tracks for other form factors are prefixed with the form factor, for example ` + "`wear:qa`",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"form_factor": schema.StringAttribute{
+				MarkdownDescription: "The form factor of the track, one of: `DEFAULT`, `WEAR`, `AUTOMOTIVE`",
+				Computed:            true,
+			},
+			"built_in": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is a track that Google Play creates for every app",
+				Computed:            true,
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+	}
+}
+
+func (r *TrackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Built-in tracks cannot be removed from an app
+	if req.Plan.Raw.IsNull() {
+		var state trackResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if isBuiltInTrack(state.Track.ValueString()) {
+			resp.Diagnostics.AddError(
+				"Cannot delete built-in track",
+				fmt.Sprintf(
+					"The %s track is built into every app and cannot be deleted. "+
+						"To stop managing it with Terraform, use `terraform state rm` instead.",
+					state.Track.ValueString(),
+				),
+			)
+		}
+		return
+	}
+
+	var plan trackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Track.IsUnknown() {
+		return
+	}
+
+	track := plan.Track.ValueString()
+	if req.State.Raw.IsNull() && isBuiltInTrack(track) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("track"),
+			"Cannot create built-in track",
+			fmt.Sprintf(
+				"The %s track already exists for every app. Import it with `terraform import` to manage it.",
+				track,
+			),
+		)
+		return
+	}
+
+	formFactor, _ := splitTrack(track)
+	plan.FormFactor = types.StringValue(formFactor)
+	plan.BuiltIn = types.BoolValue(isBuiltInTrack(track))
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *TrackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/track
+	components := strings.Split(req.ID, "/")
+	if len(components) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/track', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("track"), components[1])...)
+}
+
+func (r *TrackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data trackResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var track *androidpublisher.Track
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		var err error
+		track, err = r.client.CreateClosedTrack(
+			ctx, packageName, editID,
+			data.Track.ValueString(), data.FormFactor.ValueString(),
+		)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create track",
+			err.Error(),
+		)
+		return
+	}
+
+	data.setTrack(track)
+	data.SentForReview = types.BoolValue(sentForReview)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data trackResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var tracks []*androidpublisher.Track
+	err := r.client.ReadEdit(ctx, packageName, func(editID string) error {
+		var err error
+		tracks, err = r.client.ListTracks(ctx, packageName, editID)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch tracks",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, track := range tracks {
+		if track.Track == data.Track.ValueString() {
+			data.setTrack(track)
+
+			// Save data into Terraform state
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TrackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data trackResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other configurable attribute requires replacement, so only
+	// changes_not_sent_for_review can have changed, which only affects later
+	// commits.
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("sent_for_review"), &data.SentForReview)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data trackResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no way to delete a track, so it is only removed from
+	// Terraform state.
+	resp.Diagnostics.AddWarning(
+		"Track left in place",
+		fmt.Sprintf(
+			"Google Play does not allow tracks to be deleted, so the %s track has only been removed from Terraform state.",
+			data.Track.ValueString(),
+		),
+	)
+}
+
+func (data *trackResourceModel) setTrack(track *androidpublisher.Track) {
+	formFactor, _ := splitTrack(track.Track)
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.PackageName.ValueString(), track.Track))
+	data.Track = types.StringValue(track.Track)
+	data.FormFactor = types.StringValue(formFactor)
+	data.BuiltIn = types.BoolValue(isBuiltInTrack(track.Track))
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitTrack(t *testing.T) {
+	formFactor, name := splitTrack("qa")
+	assert.Equal(t, "DEFAULT", formFactor)
+	assert.Equal(t, "qa", name)
+
+	formFactor, name = splitTrack("wear:qa")
+	assert.Equal(t, "WEAR", formFactor)
+	assert.Equal(t, "qa", name)
+
+	formFactor, name = splitTrack("automotive:production")
+	assert.Equal(t, "AUTOMOTIVE", formFactor)
+	assert.Equal(t, "production", name)
+
+	// Only known form factors are treated as a prefix
+	formFactor, name = splitTrack("team:qa")
+	assert.Equal(t, "DEFAULT", formFactor)
+	assert.Equal(t, "team:qa", name)
+}
+
+func TestIsBuiltInTrack(t *testing.T) {
+	assert.True(t, isBuiltInTrack("production"))
+	assert.True(t, isBuiltInTrack("internal"))
+	assert.True(t, isBuiltInTrack("wear:beta"))
+	assert.False(t, isBuiltInTrack("qa"))
+	assert.False(t, isBuiltInTrack("automotive:qa"))
+}
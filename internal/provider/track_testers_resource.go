@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TrackTestersResource{}
+var _ resource.ResourceWithImportState = &TrackTestersResource{}
+
+func NewTrackTestersResource() resource.Resource {
+	return &TrackTestersResource{}
+}
+
+type TrackTestersResource struct {
+	client *GooglePlayClient
+}
+
+type trackTestersResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	PackageName  types.String `tfsdk:"package_name"`
+	Track        types.String `tfsdk:"track"`
+	GoogleGroups types.Set    `tfsdk:"google_groups"`
+
+	ChangesNotSentForReview types.Bool `tfsdk:"changes_not_sent_for_review"`
+	SentForReview           types.Bool `tfsdk:"sent_for_review"`
+}
+
+func (r *TrackTestersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_track_testers"
+}
+
+func (r *TrackTestersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `Manage the Google Groups that can test a track.
+		This resource is authoritative: any groups added to the track outside of Terraform are removed`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the testers, in the format `package_name/track`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "The package name of the app, for example `com.example.app`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"track": schema.StringAttribute{
+				MarkdownDescription: "The testing track, for example `alpha` or the name of a closed testing track",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"google_groups": schema.SetAttribute{
+				MarkdownDescription: "The email addresses of the Google Groups whose members can test the track",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"changes_not_sent_for_review": changesNotSentForReviewAttribute(),
+			"sent_for_review":             sentForReviewAttribute(),
+		},
+	}
+}
+
+func (r *TrackTestersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*GooglePlayClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *GooglePlayClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrackTestersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is: package_name/track
+	components := strings.Split(req.ID, "/")
+	if len(components) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format 'package_name/track', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), components[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("track"), components[1])...)
+}
+
+func (r *TrackTestersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data trackTestersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setTesters(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackTestersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data trackTestersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	var googleGroups []string
+	err := r.client.ReadEdit(ctx, packageName, func(editID string) error {
+		var err error
+		googleGroups, err = r.client.GetTesters(ctx, packageName, editID, data.Track.ValueString())
+		return err
+	})
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to fetch testers",
+			err.Error(),
+		)
+		return
+	}
+
+	data.GoogleGroups = stringSetValue(ctx, googleGroups, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackTestersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data trackTestersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setTesters(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrackTestersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data trackTestersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	_, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		_, err := r.client.SetTesters(ctx, packageName, editID, data.Track.ValueString(), []string{})
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove testers",
+			err.Error(),
+		)
+	}
+}
+
+func (r *TrackTestersResource) setTesters(ctx context.Context, data *trackTestersResourceModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	googleGroups := []string{}
+	diagnostics.Append(data.GoogleGroups.ElementsAs(ctx, &googleGroups, false)...)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	packageName := data.PackageName.ValueString()
+	track := data.Track.ValueString()
+	sentForReview, err := r.client.WithReviewedEdit(ctx, packageName, data.ChangesNotSentForReview.ValueBoolPointer(), func(editID string) error {
+		var err error
+		googleGroups, err = r.client.SetTesters(ctx, packageName, editID, track, googleGroups)
+		return err
+	})
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to update testers",
+			err.Error(),
+		)
+		return diagnostics
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", packageName, track))
+	data.SentForReview = types.BoolValue(sentForReview)
+	data.GoogleGroups = stringSetValue(ctx, googleGroups, &diagnostics)
+	return diagnostics
+}
+
+// stringSetValue converts strings returned by the API into a set, treating a
+// missing list as empty.
+func stringSetValue(ctx context.Context, values []string, diagnostics *diag.Diagnostics) types.Set {
+	if values == nil {
+		values = []string{}
+	}
+	set, diags := types.SetValueFrom(ctx, types.StringType, values)
+	diagnostics.Append(diags...)
+	return set
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringSetValue(t *testing.T) {
+	var diagnostics diag.Diagnostics
+
+	// The API omits the list of groups when a track has no testers
+	empty := stringSetValue(context.Background(), nil, &diagnostics)
+	assert.False(t, empty.IsNull())
+	assert.Empty(t, empty.Elements())
+
+	groups := stringSetValue(context.Background(), []string{"qa@example.com"}, &diagnostics)
+	assert.Equal(t, []attr.Value{types.StringValue("qa@example.com")}, groups.Elements())
+	assert.False(t, diagnostics.HasError())
+}